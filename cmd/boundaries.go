@@ -0,0 +1,43 @@
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+
+	"github.com/chains-lab/logium"
+	"github.com/chains-lab/places-svc/internal"
+	"github.com/chains-lab/places-svc/internal/data"
+	"github.com/chains-lab/places-svc/internal/domain/services/boundary"
+)
+
+// ImportBoundaries stores the city and district boundaries of a GeoJSON file, replacing the known ones.
+// kind is used for features without a "kind" property.
+func ImportBoundaries(ctx context.Context, cfg internal.Config, log logium.Logger, path, kind string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("open boundaries file: %w", err)
+	}
+	defer f.Close()
+
+	boundaries, err := boundary.DecodeGeoJSON(f, kind)
+	if err != nil {
+		return err
+	}
+
+	pg, err := sql.Open("postgres", cfg.Database.SQL.URL)
+	if err != nil {
+		return fmt.Errorf("connect to database: %w", err)
+	}
+	defer pg.Close()
+
+	report, err := boundary.NewService(data.New(pg)).Import(ctx, boundaries)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("boundaries import from %s: %d cities, %d districts", path, report.Cities, report.Districts)
+
+	return nil
+}
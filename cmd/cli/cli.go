@@ -32,6 +32,16 @@ func Run(args []string) bool {
 		migrateCmd     = service.Command("migrate", "migrate command")
 		migrateUpCmd   = migrateCmd.Command("up", "migrate storage up")
 		migrateDownCmd = migrateCmd.Command("down", "migrate storage down")
+
+		importCmd          = service.Command("import", "import command")
+		importPlacesCmd    = importCmd.Command("places", "import places from a CSV or GeoJSON file")
+		importPlacesFile   = importPlacesCmd.Arg("file", "path to the file").Required().String()
+		importPlacesFormat = importPlacesCmd.Flag("format", "file format: csv or geojson, by extension when omitted").String()
+		importPlacesDryRun = importPlacesCmd.Flag("dry-run", "check the rows without storing anything").Bool()
+
+		importBoundariesCmd  = importCmd.Command("boundaries", "import city and district boundaries from a GeoJSON file")
+		importBoundariesFile = importBoundariesCmd.Arg("file", "path to the file").Required().String()
+		importBoundariesKind = importBoundariesCmd.Flag("kind", "kind of features without one: city or district").Default("city").Enum("city", "district")
 	)
 
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
@@ -52,6 +62,10 @@ func Run(args []string) bool {
 		err = migrations.MigrateUp(cfg.Database.SQL.URL)
 	case migrateDownCmd.FullCommand():
 		err = migrations.MigrateDown(cfg.Database.SQL.URL)
+	case importPlacesCmd.FullCommand():
+		err = cmd.ImportPlaces(ctx, cfg, log, *importPlacesFile, *importPlacesFormat, *importPlacesDryRun)
+	case importBoundariesCmd.FullCommand():
+		err = cmd.ImportBoundaries(ctx, cfg, log, *importBoundariesFile, *importBoundariesKind)
 	default:
 		log.Errorf("unknown command %s", c)
 		return false
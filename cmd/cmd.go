@@ -8,11 +8,13 @@ import (
 	"github.com/chains-lab/logium"
 	"github.com/chains-lab/places-svc/internal"
 	"github.com/chains-lab/places-svc/internal/data"
-	"github.com/chains-lab/places-svc/internal/domain/infra/geo"
 	"github.com/chains-lab/places-svc/internal/domain/services/class"
+	"github.com/chains-lab/places-svc/internal/domain/services/pimport"
 	"github.com/chains-lab/places-svc/internal/domain/services/place"
 	"github.com/chains-lab/places-svc/internal/domain/services/plocale"
 	"github.com/chains-lab/places-svc/internal/domain/services/timetable"
+	"github.com/chains-lab/places-svc/internal/domain/services/ttemplate"
+	"github.com/chains-lab/places-svc/internal/jobs"
 	"github.com/chains-lab/places-svc/internal/rest"
 	"github.com/chains-lab/places-svc/internal/rest/controller"
 	"github.com/chains-lab/places-svc/internal/rest/middlewares"
@@ -34,16 +36,27 @@ func StartServices(ctx context.Context, cfg internal.Config, log logium.Logger,
 
 	database := data.New(pg)
 
-	geoGuesser := geo.NewGuesser()
+	geoGuesser, err := newGeocoder(cfg.Geocode)
+	if err != nil {
+		log.Fatal("failed to set up geocoder", "error", err)
+	}
 
 	classSvc := class.NewService(database)
 	placeSvc := place.NewService(database, geoGuesser)
 	pLocalesSvc := plocale.NewService(database)
 	timetableSvc := timetable.NewService(database)
+	templateSvc := ttemplate.NewService(database)
+	importSvc := pimport.NewService(database, placeSvc, pLocalesSvc, timetableSvc)
 
-	ctrl := controller.New(cfg, log, classSvc, placeSvc, pLocalesSvc, timetableSvc)
-	mdlv := middlewares.New(log)
+	ctrl := controller.New(cfg, log, classSvc, placeSvc, pLocalesSvc, timetableSvc, templateSvc, importSvc)
+	mdlv := middlewares.New(log, placeSvc)
 
 	run(func() { rest.Run(ctx, cfg, log, mdlv, ctrl) })
+	// без геокодера адреса искать нечем, места остаются в ожидании
+	if geocodingEnabled(cfg.Geocode) {
+		run(func() { jobs.RunAddresses(ctx, cfg, log, placeSvc) })
+	} else {
+		log.Info("geocoding is disabled, place address enrichment is not started")
+	}
 
 }
@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"github.com/chains-lab/places-svc/internal"
+	"github.com/chains-lab/places-svc/internal/domain/infra/geo"
+)
+
+// newGeocoder makes the geocoder chosen in the config, the public Nominatim when none is.
+func newGeocoder(cfg internal.GeocodeConfig) (geo.Provider, error) {
+	configs := make(map[string]geo.ProviderConfig, len(cfg.Providers))
+	for kind, p := range cfg.Providers {
+		configs[kind] = geo.ProviderConfig{
+			BaseURL:   p.BaseURL,
+			APIKey:    p.APIKey,
+			UserAgent: p.UserAgent,
+			Timeout:   p.Timeout,
+			Interval:  p.Interval,
+			Languages: p.Languages,
+			File:      p.File,
+		}
+	}
+
+	primary := cfg.Provider
+	if primary == "" {
+		primary = geo.ProviderNominatim
+	}
+
+	return geo.NewRegistry().Build(primary, cfg.Fallback, configs)
+}
+
+// geocodingEnabled reports whether the primary provider looks addresses up at all.
+func geocodingEnabled(cfg internal.GeocodeConfig) bool {
+	return cfg.Provider != geo.ProviderNone
+}
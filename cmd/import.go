@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/chains-lab/logium"
+	"github.com/chains-lab/places-svc/internal"
+	"github.com/chains-lab/places-svc/internal/data"
+	"github.com/chains-lab/places-svc/internal/domain/services/pimport"
+	"github.com/chains-lab/places-svc/internal/domain/services/place"
+	"github.com/chains-lab/places-svc/internal/domain/services/plocale"
+	"github.com/chains-lab/places-svc/internal/domain/services/timetable"
+)
+
+// ImportPlaces imports places from a CSV or GeoJSON file with the same rules as the import endpoint.
+// Without an explicit format it is taken from the file extension.
+func ImportPlaces(ctx context.Context, cfg internal.Config, log logium.Logger, path, format string, dryRun bool) error {
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".csv":
+			format = pimport.FormatCSV
+		case ".geojson", ".json":
+			format = pimport.FormatGeoJSON
+		default:
+			return fmt.Errorf("can not detect the format of %s, set it explicitly", path)
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("open import file: %w", err)
+	}
+	defer f.Close()
+
+	rows, err := pimport.Decode(f, format)
+	if err != nil {
+		return err
+	}
+
+	pg, err := sql.Open("postgres", cfg.Database.SQL.URL)
+	if err != nil {
+		return fmt.Errorf("connect to database: %w", err)
+	}
+	defer pg.Close()
+
+	database := data.New(pg)
+
+	geoGuesser, err := newGeocoder(cfg.Geocode)
+	if err != nil {
+		return err
+	}
+
+	placeSvc := place.NewService(database, geoGuesser)
+	importSvc := pimport.NewService(database, placeSvc, plocale.NewService(database), timetable.NewService(database))
+
+	report, err := importSvc.Import(ctx, rows, pimport.Params{DryRun: dryRun})
+	if err != nil {
+		return err
+	}
+
+	for _, row := range report.Rows {
+		if row.Result == pimport.ResultFailed {
+			log.Warnf("row %d failed: %s", row.Row, row.Reason)
+		}
+	}
+	log.Infof("places import from %s: %d created, %d updated, %d failed, dry run %t",
+		path, report.Created, report.Updated, report.Failed, report.DryRun)
+
+	return nil
+}
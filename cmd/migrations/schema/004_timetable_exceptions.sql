@@ -0,0 +1,27 @@
+-- +migrate Up
+CREATE TABLE place_timetable_exceptions (
+    id        UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    place_id  UUID         NOT NULL REFERENCES places(id) ON DELETE CASCADE,
+    date      DATE         NOT NULL,
+    start_min INT          NULL,  -- минуты от начала суток, NULL — закрыто весь день
+    end_min   INT          NULL,
+    reason    VARCHAR(255) NULL,
+
+    CHECK (
+        (start_min IS NULL AND end_min IS NULL)
+        OR (start_min >= 0 AND end_min <= 1440 AND end_min > start_min)
+    ),
+
+    -- int4range(NULL, NULL) бесконечен, поэтому «закрыто» не может соседствовать с интервалами той же даты
+    EXCLUDE USING gist (
+        place_id WITH =,
+        date WITH =,
+        int4range(start_min, end_min, '[)') WITH &&
+    )
+);
+
+CREATE INDEX place_timetable_exceptions_place_date_idx ON place_timetable_exceptions (place_id, date);
+
+-- +migrate Down
+DROP INDEX IF EXISTS place_timetable_exceptions_place_date_idx;
+DROP TABLE IF EXISTS place_timetable_exceptions CASCADE;
@@ -0,0 +1,6 @@
+-- +migrate Up
+-- IANA-зона места (например 'Europe/Kyiv'), нужна чтобы переводить момент времени в локальные минуты недели
+ALTER TABLE places ADD COLUMN timezone VARCHAR(64) NOT NULL DEFAULT 'UTC';
+
+-- +migrate Down
+ALTER TABLE places DROP COLUMN IF EXISTS timezone;
@@ -0,0 +1,58 @@
+-- +migrate Up
+-- именованные недельные расписания места; без дат — расписание по умолчанию,
+-- с valid_from / valid_to — сезонное (летнее, зимнее и т.п.)
+CREATE TABLE place_weekly_timetables (
+    id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    place_id   UUID        NOT NULL REFERENCES places(id) ON DELETE CASCADE,
+    name       VARCHAR(64) NOT NULL,
+    valid_from DATE        NULL,
+    valid_to   DATE        NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT (now() AT TIME ZONE 'UTC'),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT (now() AT TIME ZONE 'UTC'),
+
+    UNIQUE (place_id, name),
+    CHECK (valid_from IS NULL OR valid_to IS NULL OR valid_from <= valid_to)
+);
+
+CREATE INDEX place_weekly_timetables_place_idx ON place_weekly_timetables (place_id);
+
+-- существующие интервалы переезжают в расписание 'default' своего места
+INSERT INTO place_weekly_timetables (place_id, name)
+SELECT DISTINCT place_id, 'default' FROM place_timetables;
+
+ALTER TABLE place_timetables ADD COLUMN timetable_id UUID REFERENCES place_weekly_timetables(id) ON DELETE CASCADE;
+
+UPDATE place_timetables pt
+SET timetable_id = wt.id
+FROM place_weekly_timetables wt
+WHERE wt.place_id = pt.place_id AND wt.name = 'default';
+
+ALTER TABLE place_timetables ALTER COLUMN timetable_id SET NOT NULL;
+
+-- интервалы не пересекаются внутри одного расписания, разные сезоны могут
+ALTER TABLE place_timetables DROP CONSTRAINT IF EXISTS place_timetables_place_id_int4range_excl;
+ALTER TABLE place_timetables ADD CONSTRAINT place_timetables_timetable_range_excl EXCLUDE USING gist (
+    timetable_id WITH =,
+    int4range(start_min, end_min, '[)') WITH &&
+);
+
+CREATE INDEX place_timetable_timetable_idx ON place_timetables (timetable_id);
+
+-- +migrate Down
+DROP INDEX IF EXISTS place_timetable_timetable_idx;
+ALTER TABLE place_timetables DROP CONSTRAINT IF EXISTS place_timetables_timetable_range_excl;
+
+-- остаётся только расписание по умолчанию
+DELETE FROM place_timetables pt
+USING place_weekly_timetables wt
+WHERE wt.id = pt.timetable_id AND wt.name <> 'default';
+
+ALTER TABLE place_timetables ADD CONSTRAINT place_timetables_place_id_int4range_excl EXCLUDE USING gist (
+    place_id WITH =,
+    int4range(start_min, end_min, '[)') WITH &&
+);
+
+ALTER TABLE place_timetables DROP COLUMN IF EXISTS timetable_id;
+
+DROP INDEX IF EXISTS place_weekly_timetables_place_idx;
+DROP TABLE IF EXISTS place_weekly_timetables CASCADE;
@@ -0,0 +1,43 @@
+-- +migrate Up
+-- шаблоны недельного расписания компании, к которым привязываются её места
+CREATE TABLE timetable_templates (
+    id         UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    company_id UUID        NOT NULL,
+    name       VARCHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT (now() AT TIME ZONE 'UTC'),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT (now() AT TIME ZONE 'UTC'),
+
+    UNIQUE (company_id, name)
+);
+
+CREATE TABLE timetable_template_intervals (
+    id          UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    template_id UUID NOT NULL REFERENCES timetable_templates(id) ON DELETE CASCADE,
+    start_min   INT  NOT NULL,
+    end_min     INT  NOT NULL,
+
+    CHECK (start_min >= 0 AND end_min <= 10080 AND end_min > start_min),
+
+    EXCLUDE USING gist (
+        template_id WITH =,
+        int4range(start_min, end_min, '[)') WITH &&
+    )
+);
+
+CREATE INDEX timetable_template_intervals_template_idx ON timetable_template_intervals (template_id);
+
+-- привязанное расписание места хранит копию интервалов шаблона (её переписывают при изменении шаблона),
+-- поэтому фильтры по времени работают по place_timetables как обычно;
+-- при удалении шаблона место отвязывается и остаётся с последней копией
+ALTER TABLE place_weekly_timetables
+    ADD COLUMN template_id UUID NULL REFERENCES timetable_templates(id) ON DELETE SET NULL;
+
+CREATE INDEX place_weekly_timetables_template_idx ON place_weekly_timetables (template_id);
+
+-- +migrate Down
+DROP INDEX IF EXISTS place_weekly_timetables_template_idx;
+ALTER TABLE place_weekly_timetables DROP COLUMN IF EXISTS template_id;
+
+DROP INDEX IF EXISTS timetable_template_intervals_template_idx;
+DROP TABLE IF EXISTS timetable_template_intervals CASCADE;
+DROP TABLE IF EXISTS timetable_templates CASCADE;
@@ -0,0 +1,28 @@
+-- +migrate Up
+-- конфигурация полнотекстового поиска для локали; встроенного словаря для украинского нет,
+-- поэтому uk (и любые другие локали) индексируются без стемминга
+-- +migrate StatementBegin
+CREATE FUNCTION place_search_config(locale TEXT) RETURNS regconfig
+    LANGUAGE sql IMMUTABLE PARALLEL SAFE AS
+$$
+    SELECT CASE locale
+        WHEN 'en' THEN 'english'::regconfig
+        WHEN 'ru' THEN 'russian'::regconfig
+        ELSE 'simple'::regconfig
+    END
+$$;
+-- +migrate StatementEnd
+
+-- имя весит больше описания, чтобы совпадения в названии поднимались выше
+ALTER TABLE place_i18n
+    ADD COLUMN "search" tsvector GENERATED ALWAYS AS (
+        setweight(to_tsvector(place_search_config(locale), name), 'A') ||
+        setweight(to_tsvector(place_search_config(locale), description), 'B')
+    ) STORED;
+
+CREATE INDEX place_i18n_search_idx ON place_i18n USING gin ("search");
+
+-- +migrate Down
+DROP INDEX IF EXISTS place_i18n_search_idx;
+ALTER TABLE place_i18n DROP COLUMN IF EXISTS "search";
+DROP FUNCTION IF EXISTS place_search_config(TEXT);
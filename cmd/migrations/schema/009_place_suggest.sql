@@ -0,0 +1,9 @@
+-- +migrate Up
+-- триграммы для подсказок в строке поиска: префиксы и опечатки в названиях мест
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX place_i18n_name_trgm_idx ON place_i18n USING gin (name gin_trgm_ops);
+
+-- +migrate Down
+DROP INDEX IF EXISTS place_i18n_name_trgm_idx;
+DROP EXTENSION IF EXISTS pg_trgm;
@@ -0,0 +1,26 @@
+-- +migrate Up
+-- структурированный адрес места из обратного геокодирования, по одному на локаль, как place_i18n
+CREATE TABLE place_addresses (
+    "place_id"     UUID         NOT NULL REFERENCES places(id) ON DELETE CASCADE,
+    "locale"       VARCHAR(2)   NOT NULL,
+    "formatted"    VARCHAR(512) NOT NULL DEFAULT '',
+    "country"      VARCHAR(128) NOT NULL DEFAULT '',
+    "country_code" VARCHAR(2)   NOT NULL DEFAULT '',
+    "state"        VARCHAR(128) NOT NULL DEFAULT '',
+    "city"         VARCHAR(128) NOT NULL DEFAULT '',
+    "suburb"       VARCHAR(128) NOT NULL DEFAULT '',
+    "street"       VARCHAR(255) NOT NULL DEFAULT '',
+    "house_number" VARCHAR(64)  NOT NULL DEFAULT '',
+    "postcode"     VARCHAR(32)  NOT NULL DEFAULT '',
+
+    CHECK (locale ~ '^[a-z]{2}$'),
+    CHECK (country_code = '' OR country_code ~ '^[a-z]{2}$'),
+    PRIMARY KEY (place_id, locale)
+);
+
+CREATE INDEX place_addresses_country_code_idx ON place_addresses (country_code);
+CREATE INDEX place_addresses_postcode_idx ON place_addresses (postcode);
+CREATE INDEX place_addresses_street_trgm_idx ON place_addresses USING gin (street gin_trgm_ops);
+
+-- +migrate Down
+DROP TABLE IF EXISTS place_addresses CASCADE;
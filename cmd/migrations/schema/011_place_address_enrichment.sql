@@ -0,0 +1,46 @@
+-- +migrate Up
+CREATE TYPE "place_address_statuses" AS ENUM (
+    'pending',
+    'resolved',
+    'failed'
+);
+
+-- адрес ищет фоновая задача; у мест, созданных раньше, он уже найден при создании.
+-- address_retry_at — когда задача возьмёт место в следующий раз, пока статус pending
+ALTER TABLE places
+    ADD COLUMN address_status   place_address_statuses NOT NULL DEFAULT 'resolved',
+    ADD COLUMN address_attempts INTEGER                NOT NULL DEFAULT 0,
+    ADD COLUMN address_retry_at TIMESTAMPTZ            NOT NULL DEFAULT now();
+
+CREATE INDEX places_address_pending_idx ON places (address_retry_at) WHERE address_status = 'pending';
+
+-- кэш обратного геокодирования; ключ — координаты, округлённые до 1e-4 градуса и умноженные на 10^4
+CREATE TABLE geocode_cache (
+    "lon_key"      INTEGER      NOT NULL,
+    "lat_key"      INTEGER      NOT NULL,
+    "locale"       VARCHAR(2)   NOT NULL,
+    "formatted"    VARCHAR(512) NOT NULL DEFAULT '',
+    "country"      VARCHAR(128) NOT NULL DEFAULT '',
+    "country_code" VARCHAR(2)   NOT NULL DEFAULT '',
+    "state"        VARCHAR(128) NOT NULL DEFAULT '',
+    "city"         VARCHAR(128) NOT NULL DEFAULT '',
+    "suburb"       VARCHAR(128) NOT NULL DEFAULT '',
+    "street"       VARCHAR(255) NOT NULL DEFAULT '',
+    "house_number" VARCHAR(64)  NOT NULL DEFAULT '',
+    "postcode"     VARCHAR(32)  NOT NULL DEFAULT '',
+    "created_at"   TIMESTAMPTZ  NOT NULL DEFAULT now(),
+
+    CHECK (locale ~ '^[a-z]{2}$'),
+    PRIMARY KEY (lon_key, lat_key, locale)
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS geocode_cache CASCADE;
+
+DROP INDEX IF EXISTS places_address_pending_idx;
+ALTER TABLE places
+    DROP COLUMN IF EXISTS address_retry_at,
+    DROP COLUMN IF EXISTS address_attempts,
+    DROP COLUMN IF EXISTS address_status;
+
+DROP TYPE IF EXISTS "place_address_statuses";
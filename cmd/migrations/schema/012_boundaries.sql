@@ -0,0 +1,27 @@
+-- +migrate Up
+CREATE TYPE "boundary_kinds" AS ENUM (
+    'city',
+    'district'
+);
+
+-- границы городов и районов; id города совпадает с places.city_id, район ссылается на свой город
+CREATE TABLE boundaries (
+    "id"         UUID PRIMARY KEY,
+    "kind"       boundary_kinds                 NOT NULL,
+    "city_id"    UUID REFERENCES boundaries(id) ON DELETE CASCADE,
+    "name"       VARCHAR(255)                   NOT NULL DEFAULT '',
+    "geom"       geometry(MULTIPOLYGON, 4326)   NOT NULL,
+
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT (now() AT TIME ZONE 'UTC'),
+    "updated_at" TIMESTAMPTZ NOT NULL DEFAULT (now() AT TIME ZONE 'UTC'),
+
+    CHECK ((kind = 'city') = (city_id IS NULL))
+);
+
+CREATE INDEX boundaries_geom_idx ON boundaries USING gist (geom);
+CREATE INDEX boundaries_city_id_idx ON boundaries (city_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS boundaries CASCADE;
+
+DROP TYPE IF EXISTS "boundary_kinds";
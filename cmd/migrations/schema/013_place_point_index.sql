@@ -0,0 +1,8 @@
+-- +migrate Up
+-- тайлы, viewport, области и кластеры фильтруют по p.point::geometry, радиус и расстояние — по самой geography
+CREATE INDEX places_point_geom_idx ON places USING gist ((point::geometry));
+CREATE INDEX places_point_idx ON places USING gist (point);
+
+-- +migrate Down
+DROP INDEX IF EXISTS places_point_idx;
+DROP INDEX IF EXISTS places_point_geom_idx;
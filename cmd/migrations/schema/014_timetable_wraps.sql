@@ -0,0 +1,26 @@
+-- +migrate Up
+-- интервал, переходящий через конец недели (суббота → воскресенье), хранится двумя кусками:
+-- [start, 10080) с wraps = true и [0, end); без флага соседние куски считаются разными интервалами
+ALTER TABLE place_timetables ADD COLUMN wraps BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE timetable_template_intervals ADD COLUMN wraps BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- раньше куски склеивались по смежности, для уже сохранённых расписаний так и остаётся
+UPDATE place_timetables pt
+SET wraps = TRUE
+WHERE pt.end_min = 10080
+  AND EXISTS (
+      SELECT 1 FROM place_timetables head
+      WHERE head.timetable_id = pt.timetable_id AND head.start_min = 0 AND head.id <> pt.id
+  );
+
+UPDATE timetable_template_intervals ti
+SET wraps = TRUE
+WHERE ti.end_min = 10080
+  AND EXISTS (
+      SELECT 1 FROM timetable_template_intervals head
+      WHERE head.template_id = ti.template_id AND head.start_min = 0 AND head.id <> ti.id
+  );
+
+-- +migrate Down
+ALTER TABLE timetable_template_intervals DROP COLUMN IF EXISTS wraps;
+ALTER TABLE place_timetables DROP COLUMN IF EXISTS wraps;
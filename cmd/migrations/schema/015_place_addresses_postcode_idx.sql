@@ -0,0 +1,9 @@
+-- +migrate Up
+-- фильтр по почтовому индексу сравнивает нормализованный код (без пробелов, в верхнем регистре),
+-- поэтому индекс строится по тому же выражению, что и в PlacesQ.FilterPostcode
+DROP INDEX IF EXISTS place_addresses_postcode_idx;
+CREATE INDEX place_addresses_postcode_idx ON place_addresses (upper(replace(postcode, ' ', '')));
+
+-- +migrate Down
+DROP INDEX IF EXISTS place_addresses_postcode_idx;
+CREATE INDEX place_addresses_postcode_idx ON place_addresses (postcode);
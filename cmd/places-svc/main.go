@@ -2,6 +2,7 @@ package main
 
 import (
 	"os"
+	_ "time/tzdata" // the alpine runtime image ships without a zoneinfo database
 
 	"github.com/chains-lab/places-svc/cmd/cli"
 )
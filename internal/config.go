@@ -44,12 +44,37 @@ type JWTConfig struct {
 	} `mapstructure:"service"`
 }
 
+type GeocodeProviderConfig struct {
+	BaseURL   string        `mapstructure:"base_url"`
+	APIKey    string        `mapstructure:"api_key"`
+	UserAgent string        `mapstructure:"user_agent"`
+	Timeout   time.Duration `mapstructure:"timeout"`
+	Interval  time.Duration `mapstructure:"interval"`
+	Languages []string      `mapstructure:"languages"`
+	File      string        `mapstructure:"file"`
+}
+
+type GeocodeConfig struct {
+	// Provider is the kind of the geocoder, nominatim by default; Fallback is used when it fails.
+	// Providers are keyed by kind: nominatim, photon, pelias, file or none.
+	Provider  string                           `mapstructure:"provider"`
+	Fallback  string                           `mapstructure:"fallback"`
+	Providers map[string]GeocodeProviderConfig `mapstructure:"providers"`
+
+	// Enrichment is the background lookup of place addresses.
+	Enrichment struct {
+		Interval time.Duration `mapstructure:"interval"`
+		Batch    uint64        `mapstructure:"batch"`
+	} `mapstructure:"enrichment"`
+}
+
 type Config struct {
 	Service  ServeiceConfig `mapstructure:"service"`
 	Log      LogConfig      `mapstructure:"log"`
 	Rest     RestConfig     `mapstructure:"rest"`
 	JWT      JWTConfig      `mapstructure:"jwt"`
 	Database DatabaseConfig `mapstructure:"database"`
+	Geocode  GeocodeConfig  `mapstructure:"geocode"`
 }
 
 func LoadConfig() (Config, error) {
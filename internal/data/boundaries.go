@@ -0,0 +1,74 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/chains-lab/places-svc/internal/data/pgdb"
+	"github.com/chains-lab/places-svc/internal/domain/enum"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/google/uuid"
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/encoding/wkt"
+)
+
+func (d Database) UpsertBoundary(ctx context.Context, in models.Boundary) error {
+	schema := pgdb.Boundary{
+		ID:        in.ID,
+		Kind:      in.Kind,
+		Name:      in.Name,
+		Area:      wkt.MarshalString(in.Area),
+		CreatedAt: in.CreatedAt,
+		UpdatedAt: in.UpdatedAt,
+	}
+	if in.CityID != nil {
+		schema.CityID = uuid.NullUUID{UUID: *in.CityID, Valid: true}
+	}
+
+	return d.sql.boundaries.Upsert(ctx, schema)
+}
+
+// GetBoundary returns the boundary without its area, a zero one if it is unknown.
+func (d Database) GetBoundary(ctx context.Context, id uuid.UUID) (models.Boundary, error) {
+	schema, err := d.sql.boundaries.New().FilterID(id).Get(ctx)
+	switch {
+	case err == sql.ErrNoRows:
+		return models.Boundary{}, nil
+	case err != nil:
+		return models.Boundary{}, err
+	}
+
+	return boundaryFromDB(schema), nil
+}
+
+// CityBoundariesAt returns the cities covering the point without their areas, the smallest first.
+func (d Database) CityBoundariesAt(ctx context.Context, pt orb.Point) ([]models.Boundary, error) {
+	rows, err := d.sql.boundaries.New().
+		FilterKind(enum.BoundaryKindCity).
+		FilterCovers(pt).
+		OrderByArea().
+		Select(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]models.Boundary, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, boundaryFromDB(row))
+	}
+	return res, nil
+}
+
+func boundaryFromDB(schema pgdb.Boundary) models.Boundary {
+	res := models.Boundary{
+		ID:        schema.ID,
+		Kind:      schema.Kind,
+		Name:      schema.Name,
+		CreatedAt: schema.CreatedAt,
+		UpdatedAt: schema.UpdatedAt,
+	}
+	if schema.CityID.Valid {
+		res.CityID = &schema.CityID.UUID
+	}
+	return res
+}
@@ -3,6 +3,7 @@ package data
 import (
 	"context"
 	"database/sql"
+	"sort"
 
 	"github.com/chains-lab/places-svc/internal/data/pgdb"
 	"github.com/chains-lab/places-svc/internal/domain/models"
@@ -19,7 +20,15 @@ func New(pg *sql.DB) Database {
 			classes:    pgdb.NewClassesQ(pg),
 			places:     pgdb.NewPlacesQ(pg),
 			pLocales:   pgdb.NewPlaceLocalesQ(pg),
+			addresses:  pgdb.NewPlaceAddressesQ(pg),
+			geocodes:   pgdb.NewGeocodeCacheQ(pg),
+			boundaries: pgdb.NewBoundariesQ(pg),
+			suggests:   pgdb.NewPlaceSuggestionsQ(pg),
 			timetables: pgdb.NewPlaceTimetablesQ(pg),
+			exceptions: pgdb.NewPlaceTimetableExceptionsQ(pg),
+			weekly:     pgdb.NewPlaceWeeklyTimetablesQ(pg),
+			templates:  pgdb.NewTimetableTemplatesQ(pg),
+			tIntervals: pgdb.NewTimetableTemplateIntervalsQ(pg),
 		},
 	}
 }
@@ -32,7 +41,15 @@ type SqlDB struct {
 	classes    pgdb.ClassesQ
 	places     pgdb.PlacesQ
 	pLocales   pgdb.PlaceLocalesQ
+	addresses  pgdb.PlaceAddressesQ
+	geocodes   pgdb.GeocodeCacheQ
+	boundaries pgdb.BoundariesQ
+	suggests   pgdb.PlaceSuggestionsQ
 	timetables pgdb.PlaceTimetablesQ
+	exceptions pgdb.PlaceTimetableExceptionsQ
+	weekly     pgdb.PlaceWeeklyTimetablesQ
+	templates  pgdb.TimetableTemplatesQ
+	tIntervals pgdb.TimetableTemplateIntervalsQ
 }
 
 func modelFromDB(in pgdb.Place) models.Place {
@@ -58,6 +75,8 @@ func modelFromDB(in pgdb.Place) models.Place {
 		CreatedAt: p.CreatedAt,
 		UpdatedAt: p.UpdatedAt,
 
+		AddressStatus: p.AddressStatus,
+
 		Timetable: t,
 	}
 	if p.Website != nil {
@@ -81,6 +100,8 @@ func detailsFromDB(dbPlace pgdb.PlaceRow) models.PlaceDetails {
 		Address:   dbPlace.Address,
 		CreatedAt: dbPlace.CreatedAt,
 		UpdatedAt: dbPlace.UpdatedAt,
+
+		AddressStatus: dbPlace.AddressStatus,
 	}
 	if dbPlace.CompanyID.Valid {
 		place.CompanyID = &dbPlace.CompanyID.UUID
@@ -105,14 +126,83 @@ func localeFromDB(dbLoc pgdb.PlaceLocale) models.PlaceLocale {
 
 }
 
-func timetableFromDB(dbTI []pgdb.PlaceTimetableRow) models.Timetable {
-	res := models.Timetable{
-		Table: make([]models.TimeInterval, 0, len(dbTI)),
+func addressFromDB(dbAddr pgdb.PlaceAddress) models.PlaceAddress {
+	return models.PlaceAddress{
+		PlaceID:     dbAddr.PlaceID,
+		Locale:      dbAddr.Locale,
+		Formatted:   dbAddr.Formatted,
+		Country:     dbAddr.Country,
+		CountryCode: dbAddr.CountryCode,
+		State:       dbAddr.State,
+		City:        dbAddr.City,
+		Suburb:      dbAddr.Suburb,
+		Street:      dbAddr.Street,
+		HouseNumber: dbAddr.HouseNumber,
+		Postcode:    dbAddr.Postcode,
 	}
+}
+
+// timetableFromDB returns intervals ordered by start, see intervalsFromSpans.
+func timetableFromDB(dbTI []pgdb.PlaceTimetableRow) models.Timetable {
+	spans := make([]weekSpan, 0, len(dbTI))
 	for _, ti := range dbTI {
-		res.Table = append(res.Table, models.TimeInterval{
-			From: models.NumberMinutesToMoment(ti.StartMin),
-			To:   models.NumberMinutesToMoment(ti.EndMin),
+		spans = append(spans, weekSpan{start: ti.StartMin, end: ti.EndMin, wraps: ti.Wraps})
+	}
+
+	return models.Timetable{
+		Table: intervalsFromSpans(spans),
+	}
+}
+
+// weekSpan is an interval as it is stored: [start, end) minutes of the week. wraps is set on the
+// [start, WeekMinutes) half of an interval that goes on from Sunday 00:00.
+type weekSpan struct {
+	start, end int
+	wraps      bool
+}
+
+// weekSpans converts intervals to spans. An interval that wraps over the end of the week
+// (Saturday → Sunday) becomes [start, WeekMinutes) marked as wrapping plus [0, end).
+func weekSpans(table []models.TimeInterval) []weekSpan {
+	spans := make([]weekSpan, 0, len(table))
+	for _, interval := range table {
+		start, end := interval.ToNumberMinutes()
+		if start < end {
+			spans = append(spans, weekSpan{start: start, end: end})
+			continue
+		}
+
+		if end == 0 {
+			spans = append(spans, weekSpan{start: start, end: models.WeekMinutes})
+			continue
+		}
+		spans = append(spans,
+			weekSpan{start: start, end: models.WeekMinutes, wraps: true},
+			weekSpan{start: 0, end: end},
+		)
+	}
+
+	return spans
+}
+
+// intervalsFromSpans is the inverse of weekSpans: spans are ordered by start and the two halves
+// of a wrapping interval are glued back into one. Spans that merely touch at Sunday 00:00
+// stay separate intervals.
+func intervalsFromSpans(in []weekSpan) []models.TimeInterval {
+	spans := make([]weekSpan, len(in))
+	copy(spans, in)
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
+
+	if n := len(spans); n > 1 && spans[0].start == 0 && spans[n-1].wraps {
+		spans[n-1].end = spans[0].end
+		spans = spans[1:]
+	}
+
+	res := make([]models.TimeInterval, 0, len(spans))
+	for _, span := range spans {
+		res = append(res, models.TimeInterval{
+			From: models.NumberMinutesToMoment(span.start),
+			To:   models.NumberMinutesToMoment(span.end),
 		})
 	}
 
@@ -0,0 +1,57 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/chains-lab/places-svc/internal/data/pgdb"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+)
+
+// GetGeocodeCache returns the address cached for the key after since, nil if there is none.
+func (d Database) GetGeocodeCache(
+	ctx context.Context,
+	lonKey, latKey int32,
+	locale string,
+	since time.Time,
+) (*models.PlaceAddress, error) {
+	schema, err := d.sql.geocodes.New().FilterKey(lonKey, latKey, locale).FilterCreatedAfter(since).Get(ctx)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+
+	return &models.PlaceAddress{
+		Locale:      schema.Locale,
+		Formatted:   schema.Formatted,
+		Country:     schema.Country,
+		CountryCode: schema.CountryCode,
+		State:       schema.State,
+		City:        schema.City,
+		Suburb:      schema.Suburb,
+		Street:      schema.Street,
+		HouseNumber: schema.HouseNumber,
+		Postcode:    schema.Postcode,
+	}, nil
+}
+
+func (d Database) PutGeocodeCache(ctx context.Context, lonKey, latKey int32, addr models.PlaceAddress, at time.Time) error {
+	return d.sql.geocodes.Upsert(ctx, pgdb.GeocodeCache{
+		LonKey:      lonKey,
+		LatKey:      latKey,
+		Locale:      addr.Locale,
+		Formatted:   addr.Formatted,
+		Country:     addr.Country,
+		CountryCode: addr.CountryCode,
+		State:       addr.State,
+		City:        addr.City,
+		Suburb:      addr.Suburb,
+		Street:      addr.Street,
+		HouseNumber: addr.HouseNumber,
+		Postcode:    addr.Postcode,
+		CreatedAt:   at,
+	})
+}
@@ -0,0 +1,153 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/paulmach/orb"
+)
+
+const boundariesTable = "boundaries"
+
+// Boundary is a city or a district. Area is the WKT of the multipolygon, it is only written,
+// reading boundaries leaves it empty.
+type Boundary struct {
+	ID        uuid.UUID     `storage:"id"`
+	Kind      string        `storage:"kind"`
+	CityID    uuid.NullUUID `storage:"city_id"`
+	Name      string        `storage:"name"`
+	Area      string        `storage:"geom"`
+	CreatedAt time.Time     `storage:"created_at"`
+	UpdatedAt time.Time     `storage:"updated_at"`
+}
+
+type BoundariesQ struct {
+	db       *sql.DB
+	selector sq.SelectBuilder
+}
+
+func NewBoundariesQ(db *sql.DB) BoundariesQ {
+	b := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	return BoundariesQ{
+		db: db,
+		selector: b.Select(
+			"b.id",
+			"b.kind",
+			"b.city_id",
+			"b.name",
+			"b.created_at",
+			"b.updated_at",
+		).From(boundariesTable + " AS b"),
+	}
+}
+
+func (q BoundariesQ) New() BoundariesQ { return NewBoundariesQ(q.db) }
+
+func scanBoundary(scanner interface{ Scan(dest ...any) error }) (Boundary, error) {
+	var b Boundary
+	if err := scanner.Scan(
+		&b.ID,
+		&b.Kind,
+		&b.CityID,
+		&b.Name,
+		&b.CreatedAt,
+		&b.UpdatedAt,
+	); err != nil {
+		return Boundary{}, err
+	}
+	return b, nil
+}
+
+// Upsert stores the boundary, a boundary already stored with the same id is replaced.
+// Self-intersecting rings are repaired by PostGIS, only the polygons of the repaired geometry are kept.
+func (q BoundariesQ) Upsert(ctx context.Context, in Boundary) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (id, kind, city_id, name, geom, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, ST_Multi(ST_CollectionExtract(ST_MakeValid(ST_SetSRID(ST_GeomFromText($5), 4326)), 3)), $6, $6)
+		ON CONFLICT (id) DO UPDATE
+		SET kind = EXCLUDED.kind,
+		    city_id = EXCLUDED.city_id,
+		    name = EXCLUDED.name,
+		    geom = EXCLUDED.geom,
+		    updated_at = EXCLUDED.updated_at
+	`, boundariesTable)
+	args := []any{in.ID, in.Kind, in.CityID, in.Name, in.Area, in.UpdatedAt}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err := tx.ExecContext(ctx, query, args...)
+		return err
+	}
+	_, err := q.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (q BoundariesQ) Get(ctx context.Context) (Boundary, error) {
+	query, args, err := q.selector.Limit(1).ToSql()
+	if err != nil {
+		return Boundary{}, fmt.Errorf("build select %s: %w", boundariesTable, err)
+	}
+
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+	return scanBoundary(row)
+}
+
+func (q BoundariesQ) Select(ctx context.Context) ([]Boundary, error) {
+	query, args, err := q.selector.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build select %s: %w", boundariesTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []Boundary
+	for rows.Next() {
+		b, err := scanBoundary(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, b)
+	}
+	return out, rows.Err()
+}
+
+func (q BoundariesQ) FilterID(id ...uuid.UUID) BoundariesQ {
+	q.selector = q.selector.Where(sq.Eq{"b.id": id})
+	return q
+}
+
+func (q BoundariesQ) FilterKind(kind ...string) BoundariesQ {
+	q.selector = q.selector.Where(sq.Eq{"b.kind": kind})
+	return q
+}
+
+// FilterCovers keeps boundaries the point lies inside of or on the border of.
+func (q BoundariesQ) FilterCovers(pt orb.Point) BoundariesQ {
+	cond := sq.Expr("ST_Covers(b.geom, ST_SetSRID(ST_MakePoint(?, ?), 4326))", pt[0], pt[1])
+	q.selector = q.selector.Where(cond)
+	return q
+}
+
+// OrderByArea puts the smallest boundary first, so of nested or overlapping boundaries the most precise one wins.
+func (q BoundariesQ) OrderByArea() BoundariesQ {
+	q.selector = q.selector.OrderBy("ST_Area(b.geom)", "b.id")
+	return q
+}
@@ -0,0 +1,133 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+const geocodeCacheTable = "geocode_cache"
+
+// GeocodeCache is a stored reverse geocoding result, keyed by rounded coordinates and locale.
+type GeocodeCache struct {
+	LonKey      int32     `storage:"lon_key"`
+	LatKey      int32     `storage:"lat_key"`
+	Locale      string    `storage:"locale"`
+	Formatted   string    `storage:"formatted"`
+	Country     string    `storage:"country"`
+	CountryCode string    `storage:"country_code"`
+	State       string    `storage:"state"`
+	City        string    `storage:"city"`
+	Suburb      string    `storage:"suburb"`
+	Street      string    `storage:"street"`
+	HouseNumber string    `storage:"house_number"`
+	Postcode    string    `storage:"postcode"`
+	CreatedAt   time.Time `storage:"created_at"`
+}
+
+type GeocodeCacheQ struct {
+	db       *sql.DB
+	selector sq.SelectBuilder
+}
+
+func NewGeocodeCacheQ(db *sql.DB) GeocodeCacheQ {
+	b := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	return GeocodeCacheQ{
+		db: db,
+		selector: b.Select(
+			"lon_key",
+			"lat_key",
+			"locale",
+			"formatted",
+			"country",
+			"country_code",
+			"state",
+			"city",
+			"suburb",
+			"street",
+			"house_number",
+			"postcode",
+			"created_at",
+		).From(geocodeCacheTable),
+	}
+}
+
+func (q GeocodeCacheQ) New() GeocodeCacheQ { return NewGeocodeCacheQ(q.db) }
+
+// Upsert stores the result, a result already cached for the key is replaced and its age restarts.
+func (q GeocodeCacheQ) Upsert(ctx context.Context, in GeocodeCache) error {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (lon_key, lat_key, locale, formatted, country, country_code, state, city, suburb, street, house_number, postcode, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		ON CONFLICT (lon_key, lat_key, locale) DO UPDATE
+		SET formatted = EXCLUDED.formatted,
+		    country = EXCLUDED.country,
+		    country_code = EXCLUDED.country_code,
+		    state = EXCLUDED.state,
+		    city = EXCLUDED.city,
+		    suburb = EXCLUDED.suburb,
+		    street = EXCLUDED.street,
+		    house_number = EXCLUDED.house_number,
+		    postcode = EXCLUDED.postcode,
+		    created_at = EXCLUDED.created_at
+	`, geocodeCacheTable)
+	args := []any{
+		in.LonKey, in.LatKey, SanitizeLocale(in.Locale), in.Formatted, in.Country, strings.ToLower(in.CountryCode),
+		in.State, in.City, in.Suburb, in.Street, in.HouseNumber, in.Postcode, in.CreatedAt,
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err := tx.ExecContext(ctx, query, args...)
+		return err
+	}
+	_, err := q.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (q GeocodeCacheQ) Get(ctx context.Context) (GeocodeCache, error) {
+	query, args, err := q.selector.Limit(1).ToSql()
+	if err != nil {
+		return GeocodeCache{}, fmt.Errorf("build select %s: %w", geocodeCacheTable, err)
+	}
+
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+
+	var c GeocodeCache
+	err = row.Scan(
+		&c.LonKey,
+		&c.LatKey,
+		&c.Locale,
+		&c.Formatted,
+		&c.Country,
+		&c.CountryCode,
+		&c.State,
+		&c.City,
+		&c.Suburb,
+		&c.Street,
+		&c.HouseNumber,
+		&c.Postcode,
+		&c.CreatedAt,
+	)
+	return c, err
+}
+
+func (q GeocodeCacheQ) FilterKey(lonKey, latKey int32, locale string) GeocodeCacheQ {
+	where := sq.Eq{"lon_key": lonKey, "lat_key": latKey, "locale": locale}
+	q.selector = q.selector.Where(where)
+	return q
+}
+
+func (q GeocodeCacheQ) FilterCreatedAfter(t time.Time) GeocodeCacheQ {
+	q.selector = q.selector.Where(sq.Gt{"created_at": t})
+	return q
+}
@@ -0,0 +1,129 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/paulmach/orb"
+)
+
+// PlaceAddressJob is a place claimed by the address lookup. Lease is the claim, it is checked
+// when the result is stored so a place changed in the meantime is not overwritten.
+type PlaceAddressJob struct {
+	ID       uuid.UUID
+	Point    orb.Point
+	Address  string
+	Attempts int
+	Locales  []string
+	Lease    time.Time
+}
+
+// ClaimAddressJobs takes up to limit pending places due at now and moves their retry time to lease,
+// so other workers skip them until the lease runs out. Locales are the localizations of the place.
+func (q PlacesQ) ClaimAddressJobs(ctx context.Context, now, lease time.Time, limit uint64) ([]PlaceAddressJob, error) {
+	// Postgres хранит микросекунды, иначе аренда не совпадёт при проверке
+	lease = lease.UTC().Truncate(time.Microsecond)
+
+	query := fmt.Sprintf(`
+		UPDATE %[1]s AS p SET address_retry_at = $1
+		WHERE p.id IN (
+			SELECT id FROM %[1]s
+			WHERE address_status = 'pending' AND address_retry_at <= $2
+			ORDER BY address_retry_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING p.id,
+			ST_X(p.point::geometry),
+			ST_Y(p.point::geometry),
+			p.address,
+			p.address_attempts,
+			ARRAY(SELECT i.locale FROM %[2]s i WHERE i.place_id = p.id ORDER BY i.locale)
+	`, placesTable, placeLocalizationTable)
+
+	var (
+		rows *sql.Rows
+		err  error
+	)
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, lease, now, limit)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, lease, now, limit)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("claim address jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var out []PlaceAddressJob
+	for rows.Next() {
+		var (
+			job      = PlaceAddressJob{Lease: lease}
+			lon, lat float64
+		)
+		if err = rows.Scan(&job.ID, &lon, &lat, &job.Address, &job.Attempts, pq.Array(&job.Locales)); err != nil {
+			return nil, fmt.Errorf("scan address job: %w", err)
+		}
+		job.Point = orb.Point{lon, lat}
+		out = append(out, job)
+	}
+
+	return out, rows.Err()
+}
+
+// SetAddressStatus stores the state of the address lookup of the place. With a non-zero lease only a place
+// still claimed with it is updated, the result tells whether it was.
+func (q PlacesQ) SetAddressStatus(
+	ctx context.Context,
+	placeID uuid.UUID,
+	lease time.Time,
+	status string,
+	attempts int,
+	retryAt time.Time,
+) (bool, error) {
+	query := fmt.Sprintf(`
+		UPDATE %s SET address_status = $1, address_attempts = $2, address_retry_at = $3
+		WHERE id = $4 AND ($5::timestamptz IS NULL OR address_retry_at = $5)
+	`, placesTable)
+
+	var claim sql.NullTime
+	if !lease.IsZero() {
+		claim = sql.NullTime{Time: lease, Valid: true}
+	}
+
+	var (
+		res sql.Result
+		err error
+	)
+	if tx, ok := TxFromCtx(ctx); ok {
+		res, err = tx.ExecContext(ctx, query, status, attempts, retryAt, placeID, claim)
+	} else {
+		res, err = q.db.ExecContext(ctx, query, status, attempts, retryAt, placeID, claim)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// FillAddress sets the one line address of the place unless it already has one.
+func (q PlacesQ) FillAddress(ctx context.Context, placeID uuid.UUID, address string) error {
+	query := fmt.Sprintf(`UPDATE %s SET address = $1 WHERE id = $2 AND address = ''`, placesTable)
+
+	var err error
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, address, placeID)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, address, placeID)
+	}
+	return err
+}
@@ -0,0 +1,178 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+const placeAddressesTable = "place_addresses"
+
+// PlaceAddress is the structured address of a place in one locale. The json tags match the columns,
+// so a row can be read back from row_to_json.
+type PlaceAddress struct {
+	PlaceID     uuid.UUID `storage:"place_id" json:"place_id"`
+	Locale      string    `storage:"locale" json:"locale"`
+	Formatted   string    `storage:"formatted" json:"formatted"`
+	Country     string    `storage:"country" json:"country"`
+	CountryCode string    `storage:"country_code" json:"country_code"`
+	State       string    `storage:"state" json:"state"`
+	City        string    `storage:"city" json:"city"`
+	Suburb      string    `storage:"suburb" json:"suburb"`
+	Street      string    `storage:"street" json:"street"`
+	HouseNumber string    `storage:"house_number" json:"house_number"`
+	Postcode    string    `storage:"postcode" json:"postcode"`
+}
+
+type PlaceAddressesQ struct {
+	db       *sql.DB
+	selector sq.SelectBuilder
+	deleter  sq.DeleteBuilder
+}
+
+func NewPlaceAddressesQ(db *sql.DB) PlaceAddressesQ {
+	b := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	return PlaceAddressesQ{
+		db: db,
+		selector: b.Select(
+			"place_id",
+			"locale",
+			"formatted",
+			"country",
+			"country_code",
+			"state",
+			"city",
+			"suburb",
+			"street",
+			"house_number",
+			"postcode",
+		).From(placeAddressesTable),
+		deleter: b.Delete(placeAddressesTable),
+	}
+}
+
+func (q PlaceAddressesQ) New() PlaceAddressesQ { return NewPlaceAddressesQ(q.db) }
+
+func (q PlaceAddressesQ) Upsert(ctx context.Context, in ...PlaceAddress) error {
+	if len(in) == 0 {
+		return nil
+	}
+
+	const cols = "(place_id, locale, formatted, country, country_code, state, city, suburb, street, house_number, postcode)"
+	var (
+		args []any
+		ph   []string
+		i    = 1
+	)
+	for _, row := range in {
+		marks := make([]string, 0, 11)
+		for j := 0; j < 11; j++ {
+			marks = append(marks, fmt.Sprintf("$%d", i+j))
+		}
+		ph = append(ph, "("+strings.Join(marks, ",")+")")
+		i += 11
+		args = append(args,
+			row.PlaceID, SanitizeLocale(row.Locale), row.Formatted, row.Country, strings.ToLower(row.CountryCode),
+			row.State, row.City, row.Suburb, row.Street, row.HouseNumber, row.Postcode,
+		)
+	}
+	query := fmt.Sprintf(`
+		INSERT INTO %s %s VALUES %s
+		ON CONFLICT (place_id, locale) DO UPDATE
+		SET formatted = EXCLUDED.formatted,
+		    country = EXCLUDED.country,
+		    country_code = EXCLUDED.country_code,
+		    state = EXCLUDED.state,
+		    city = EXCLUDED.city,
+		    suburb = EXCLUDED.suburb,
+		    street = EXCLUDED.street,
+		    house_number = EXCLUDED.house_number,
+		    postcode = EXCLUDED.postcode
+	`, placeAddressesTable, cols, strings.Join(ph, ","))
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err := tx.ExecContext(ctx, query, args...)
+		return err
+	}
+	_, err := q.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (q PlaceAddressesQ) Select(ctx context.Context) ([]PlaceAddress, error) {
+	query, args, err := q.selector.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build select %s: %w", placeAddressesTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []PlaceAddress
+	for rows.Next() {
+		var a PlaceAddress
+		if err := rows.Scan(
+			&a.PlaceID,
+			&a.Locale,
+			&a.Formatted,
+			&a.Country,
+			&a.CountryCode,
+			&a.State,
+			&a.City,
+			&a.Suburb,
+			&a.Street,
+			&a.HouseNumber,
+			&a.Postcode,
+		); err != nil {
+			return nil, fmt.Errorf("scan %s: %w", placeAddressesTable, err)
+		}
+		out = append(out, a)
+	}
+	return out, rows.Err()
+}
+
+func (q PlaceAddressesQ) Delete(ctx context.Context) error {
+	query, args, err := q.deleter.ToSql()
+	if err != nil {
+		return fmt.Errorf("build delete %s: %w", placeAddressesTable, err)
+	}
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+	return err
+}
+
+func (q PlaceAddressesQ) FilterPlaceID(id uuid.UUID) PlaceAddressesQ {
+	q.selector = q.selector.Where(sq.Eq{"place_id": id})
+	q.deleter = q.deleter.Where(sq.Eq{"place_id": id})
+	return q
+}
+
+func (q PlaceAddressesQ) FilterLocale(locale string) PlaceAddressesQ {
+	q.selector = q.selector.Where(sq.Eq{"locale": locale})
+	q.deleter = q.deleter.Where(sq.Eq{"locale": locale})
+	return q
+}
+
+func (q PlaceAddressesQ) OrderByLocale(asc bool) PlaceAddressesQ {
+	dir := "DESC"
+	if asc {
+		dir = "ASC"
+	}
+	q.selector = q.selector.OrderBy("locale " + dir)
+	return q
+}
@@ -0,0 +1,161 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/paulmach/orb"
+)
+
+// PlaceExport is a place with all its localizations and the weekly timetable in force today.
+type PlaceExport struct {
+	PlaceRow
+	Locales   []PlaceLocale
+	Timetable []PlaceTimetableRow
+}
+
+const placesExportCursor = "places_export"
+
+// Export streams the filtered places ordered by id through a server-side cursor, fetching batch rows at a time,
+// so the result set is never held in memory. It stops at the first error returned by fn.
+// The cursor lives in the transaction of ctx, or in a read-only transaction of its own.
+func (q PlacesQ) Export(ctx context.Context, batch uint64, fn func(PlaceExport) error) error {
+	locales := sq.Expr("COALESCE((" +
+		"SELECT json_agg(json_build_object('locale', i.locale, 'name', i.name, 'description', i.description)" +
+		" ORDER BY i.locale)" +
+		" FROM " + placeLocalizationTable + " i WHERE i.place_id = p.id" +
+		"), '[]'::json) AS locales_json")
+
+	query, args, err := q.WithTimetable().selector.
+		Column(locales).
+		OrderBy("p.id").
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("building export query for %s: %w", placesTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		return exportWithCursor(ctx, tx, query, args, batch, fn)
+	}
+
+	tx, err := q.db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return fmt.Errorf("failed to start export transaction: %w", err)
+	}
+	// только чтение: откат просто закрывает курсор и транзакцию
+	defer func() { _ = tx.Rollback() }()
+
+	return exportWithCursor(ctx, tx, query, args, batch, fn)
+}
+
+func exportWithCursor(
+	ctx context.Context,
+	tx *sql.Tx,
+	query string,
+	args []any,
+	batch uint64,
+	fn func(PlaceExport) error,
+) error {
+	if _, err := tx.ExecContext(ctx, "DECLARE "+placesExportCursor+" NO SCROLL CURSOR FOR "+query, args...); err != nil {
+		return fmt.Errorf("declare export cursor: %w", err)
+	}
+
+	fetch := fmt.Sprintf("FETCH FORWARD %d FROM %s", batch, placesExportCursor)
+	for {
+		n, err := fetchExportBatch(ctx, tx, fetch, fn)
+		if err != nil {
+			return err
+		}
+		if n < batch {
+			break
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, "CLOSE "+placesExportCursor); err != nil {
+		return fmt.Errorf("close export cursor: %w", err)
+	}
+
+	return nil
+}
+
+func fetchExportBatch(ctx context.Context, tx *sql.Tx, fetch string, fn func(PlaceExport) error) (uint64, error) {
+	rows, err := tx.QueryContext(ctx, fetch)
+	if err != nil {
+		return 0, fmt.Errorf("fetch export cursor: %w", err)
+	}
+	defer rows.Close()
+
+	var n uint64
+	for rows.Next() {
+		item, err := scanPlaceExport(rows)
+		if err != nil {
+			return n, err
+		}
+		n++
+
+		if err = fn(item); err != nil {
+			return n, err
+		}
+	}
+
+	return n, rows.Err()
+}
+
+func scanPlaceExport(scanner interface{ Scan(dest ...any) error }) (PlaceExport, error) {
+	var (
+		p           PlaceRow
+		lon, lat    float64
+		ttJSON      []byte
+		localesJSON []byte
+	)
+
+	if err := scanner.Scan(
+		&p.ID,
+		&p.CityID,
+		&p.CompanyID,
+		&p.Class,
+		&p.Status,
+		&p.Verified,
+		&lon,
+		&lat,
+		&p.Address,
+		&p.Website,
+		&p.Phone,
+		&p.Timezone,
+		&p.AddressStatus,
+		&p.CreatedAt,
+		&p.UpdatedAt,
+		&ttJSON,
+		&localesJSON,
+	); err != nil {
+		return PlaceExport{}, fmt.Errorf("scan exported place: %w", err)
+	}
+	p.Point = orb.Point{lon, lat}
+
+	out := PlaceExport{PlaceRow: p}
+	if err := json.Unmarshal(ttJSON, &out.Timetable); err != nil {
+		return PlaceExport{}, fmt.Errorf("unmarshal timetable: %w", err)
+	}
+
+	var locales []struct {
+		Locale      string `json:"locale"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+	if err := json.Unmarshal(localesJSON, &locales); err != nil {
+		return PlaceExport{}, fmt.Errorf("unmarshal locales: %w", err)
+	}
+	for _, l := range locales {
+		out.Locales = append(out.Locales, PlaceLocale{
+			PlaceID:     p.ID,
+			Locale:      l.Locale,
+			Name:        l.Name,
+			Description: l.Description,
+		})
+	}
+
+	return out, nil
+}
@@ -0,0 +1,145 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/chains-lab/places-svc/internal/domain/enum"
+	"github.com/google/uuid"
+	"github.com/paulmach/orb"
+)
+
+type PlaceSuggestion struct {
+	PlaceID  uuid.UUID       `storage:"place_id"`
+	Class    string          `storage:"class"`
+	Locale   string          `storage:"locale"`
+	Name     string          `storage:"name"`
+	Distance sql.NullFloat64 `storage:"distance_m"`
+}
+
+// PlaceSuggestionsQ looks up active places whose localized name is similar to a typed-in text.
+// It reads place_i18n through the trigram index only and never counts the matches.
+type PlaceSuggestionsQ struct {
+	db *sql.DB
+
+	text   string
+	locale string
+	point  *orb.Point
+	limit  uint64
+}
+
+func NewPlaceSuggestionsQ(db *sql.DB) PlaceSuggestionsQ {
+	return PlaceSuggestionsQ{
+		db:     db,
+		locale: enum.LocaleEN,
+		limit:  10,
+	}
+}
+
+func (q PlaceSuggestionsQ) New() PlaceSuggestionsQ {
+	return NewPlaceSuggestionsQ(q.db)
+}
+
+// Match sets the typed-in text, it matches both as a prefix of the name and as a fuzzy word.
+func (q PlaceSuggestionsQ) Match(text string) PlaceSuggestionsQ {
+	q.text = text
+	return q
+}
+
+// Locale sets the preferred locale, its name wins over the other localizations of the same place.
+func (q PlaceSuggestionsQ) Locale(locale string) PlaceSuggestionsQ {
+	q.locale = SanitizeLocale(locale)
+	return q
+}
+
+// Near boosts places close to the point and attaches the distance to it.
+func (q PlaceSuggestionsQ) Near(point orb.Point) PlaceSuggestionsQ {
+	q.point = &point
+	return q
+}
+
+func (q PlaceSuggestionsQ) Limit(limit uint64) PlaceSuggestionsQ {
+	q.limit = limit
+	return q
+}
+
+func (q PlaceSuggestionsQ) Select(ctx context.Context) ([]PlaceSuggestion, error) {
+	b := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	prefix := escapeLike(q.text) + "%"
+
+	distance := sq.Expr("NULL::float8")
+	boost := sq.Expr("0")
+	if q.point != nil {
+		distance = sq.Expr(
+			"ST_Distance(p.point, ST_SetSRID(ST_MakePoint(?, ?), 4326)::geography)",
+			q.point[0], q.point[1],
+		)
+		// до +0.5 за близость: на 1 км половина бонуса, дальше быстро затухает
+		boost = sq.Expr("0.5 / (1 + d.distance_m / 1000)")
+	}
+
+	// одна лучшая локализация на место: похожесть, точный префикс и предпочтительная локаль
+	candidates := sq.Select(
+		"DISTINCT ON (p.id) p.id",
+		"p.class",
+		"i.locale",
+		"i.name",
+		"d.distance_m",
+	).
+		Column(sq.Expr(
+			"word_similarity(?, i.name)"+
+				" + CASE WHEN i.name ILIKE ? THEN 0.3 ELSE 0 END"+
+				" + CASE WHEN i.locale = ? THEN 0.1 ELSE 0 END"+
+				" + (?) AS score",
+			q.text, prefix, q.locale, boost,
+		)).
+		From(placeLocalizationTable+" i").
+		Join(placesTable+" p ON p.id = i.place_id").
+		JoinClause(sq.Expr("CROSS JOIN LATERAL (SELECT (?) AS distance_m) d", distance)).
+		Where("p.status = ?", enum.PlaceStatusActive).
+		Where(sq.Or{
+			sq.Expr("? <% i.name", q.text),
+			sq.Expr("i.name ILIKE ?", prefix),
+		}).
+		OrderBy("p.id", "score DESC")
+
+	query, args, err := b.Select("id", "class", "locale", "name", "distance_m").
+		FromSelect(candidates, "s").
+		OrderBy("score DESC", "name").
+		Limit(q.limit).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building suggest query for %s: %w", placeLocalizationTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []PlaceSuggestion
+	for rows.Next() {
+		var s PlaceSuggestion
+		if err := rows.Scan(&s.PlaceID, &s.Class, &s.Locale, &s.Name, &s.Distance); err != nil {
+			return nil, fmt.Errorf("scan place suggestion: %w", err)
+		}
+		out = append(out, s)
+	}
+	return out, rows.Err()
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
@@ -13,10 +13,12 @@ import (
 const placeTimetablesTable = "place_timetables"
 
 type PlaceTimetableRow struct {
-	ID       uuid.UUID `storage:"id"        json:"id"`
-	PlaceID  uuid.UUID `storage:"place_id"  json:"place_id"`
-	StartMin int       `storage:"start_min" json:"start_min"`
-	EndMin   int       `storage:"end_min"   json:"end_min"`
+	ID          uuid.UUID `storage:"id"           json:"id"`
+	PlaceID     uuid.UUID `storage:"place_id"     json:"place_id"`
+	TimetableID uuid.UUID `storage:"timetable_id" json:"timetable_id"`
+	StartMin    int       `storage:"start_min"    json:"start_min"`
+	EndMin      int       `storage:"end_min"      json:"end_min"`
+	Wraps       bool      `storage:"wraps"        json:"wraps"`
 }
 
 type PlaceTimetablesQ struct {
@@ -36,8 +38,10 @@ func NewPlaceTimetablesQ(db *sql.DB) PlaceTimetablesQ {
 		selector: b.Select(
 			"id",
 			"place_id",
+			"timetable_id",
 			"start_min",
 			"end_min",
+			"wraps",
 		).From(placeTimetablesTable),
 		inserter: b.Insert(placeTimetablesTable),
 		updater:  b.Update(placeTimetablesTable),
@@ -53,9 +57,9 @@ func (q PlaceTimetablesQ) Insert(ctx context.Context, in ...PlaceTimetableRow) e
 		return nil
 	}
 
-	ins := q.inserter.Columns("id", "place_id", "start_min", "end_min")
+	ins := q.inserter.Columns("id", "place_id", "timetable_id", "start_min", "end_min", "wraps")
 	for _, t := range in {
-		ins = ins.Values(t.ID, t.PlaceID, t.StartMin, t.EndMin)
+		ins = ins.Values(t.ID, t.PlaceID, t.TimetableID, t.StartMin, t.EndMin, t.Wraps)
 	}
 
 	query, args, err := ins.ToSql()
@@ -76,24 +80,26 @@ func (q PlaceTimetablesQ) Upsert(ctx context.Context, in ...PlaceTimetableRow) e
 		return nil
 	}
 
-	const cols = "(id, place_id, start_min, end_min)"
+	const cols = "(id, place_id, timetable_id, start_min, end_min, wraps)"
 	var (
 		args []any
 		ph   []string
 		i    = 1
 	)
 	for _, r := range in {
-		ph = append(ph, fmt.Sprintf("($%d,$%d,$%d,$%d)", i, i+1, i+2, i+3))
-		args = append(args, r.ID, r.PlaceID, r.StartMin, r.EndMin)
-		i += 4
+		ph = append(ph, fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d)", i, i+1, i+2, i+3, i+4, i+5))
+		args = append(args, r.ID, r.PlaceID, r.TimetableID, r.StartMin, r.EndMin, r.Wraps)
+		i += 6
 	}
 
 	query := fmt.Sprintf(`
 		INSERT INTO %s %s VALUES %s
 		ON CONFLICT (id) DO UPDATE
 		SET place_id = EXCLUDED.place_id,
+		    timetable_id = EXCLUDED.timetable_id,
 		    start_min = EXCLUDED.start_min,
-		    end_min   = EXCLUDED.end_min
+		    end_min   = EXCLUDED.end_min,
+		    wraps     = EXCLUDED.wraps
 	`, placeTimetablesTable, cols, strings.Join(ph, ","))
 
 	if tx, ok := TxFromCtx(ctx); ok {
@@ -118,7 +124,7 @@ func (q PlaceTimetablesQ) Get(ctx context.Context) (PlaceTimetableRow, error) {
 	}
 
 	var out PlaceTimetableRow
-	if err := row.Scan(&out.ID, &out.PlaceID, &out.StartMin, &out.EndMin); err != nil {
+	if err := row.Scan(&out.ID, &out.PlaceID, &out.TimetableID, &out.StartMin, &out.EndMin, &out.Wraps); err != nil {
 		return out, err
 	}
 	return out, nil
@@ -144,7 +150,7 @@ func (q PlaceTimetablesQ) Select(ctx context.Context) ([]PlaceTimetableRow, erro
 	var out []PlaceTimetableRow
 	for rows.Next() {
 		var t PlaceTimetableRow
-		if err := rows.Scan(&t.ID, &t.PlaceID, &t.StartMin, &t.EndMin); err != nil {
+		if err := rows.Scan(&t.ID, &t.PlaceID, &t.TimetableID, &t.StartMin, &t.EndMin, &t.Wraps); err != nil {
 			return nil, err
 		}
 		out = append(out, t)
@@ -181,6 +187,14 @@ func (q PlaceTimetablesQ) FilterPlaceID(placeID uuid.UUID) PlaceTimetablesQ {
 	return q
 }
 
+func (q PlaceTimetablesQ) FilterTimetableID(timetableID uuid.UUID) PlaceTimetablesQ {
+	q.selector = q.selector.Where(sq.Eq{"timetable_id": timetableID})
+	q.updater = q.updater.Where(sq.Eq{"timetable_id": timetableID})
+	q.deleter = q.deleter.Where(sq.Eq{"timetable_id": timetableID})
+	q.counter = q.counter.Where(sq.Eq{"timetable_id": timetableID})
+	return q
+}
+
 func (q PlaceTimetablesQ) FilterBetween(start, end int) PlaceTimetablesQ {
 	const week = 7 * 24 * 60 // 10080
 
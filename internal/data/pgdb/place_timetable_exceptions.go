@@ -0,0 +1,177 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+const placeTimetableExceptionsTable = "place_timetable_exceptions"
+
+type PlaceTimetableExceptionRow struct {
+	ID       uuid.UUID      `storage:"id"`
+	PlaceID  uuid.UUID      `storage:"place_id"`
+	Date     time.Time      `storage:"date"`
+	StartMin sql.NullInt64  `storage:"start_min"`
+	EndMin   sql.NullInt64  `storage:"end_min"`
+	Reason   sql.NullString `storage:"reason"`
+}
+
+type PlaceTimetableExceptionsQ struct {
+	db       *sql.DB
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+	deleter  sq.DeleteBuilder
+	counter  sq.SelectBuilder
+}
+
+func NewPlaceTimetableExceptionsQ(db *sql.DB) PlaceTimetableExceptionsQ {
+	b := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	return PlaceTimetableExceptionsQ{
+		db: db,
+		selector: b.Select(
+			"id",
+			"place_id",
+			"date",
+			"start_min",
+			"end_min",
+			"reason",
+		).From(placeTimetableExceptionsTable),
+		inserter: b.Insert(placeTimetableExceptionsTable),
+		deleter:  b.Delete(placeTimetableExceptionsTable),
+		counter:  b.Select("COUNT(*) AS count").From(placeTimetableExceptionsTable),
+	}
+}
+
+func (q PlaceTimetableExceptionsQ) New() PlaceTimetableExceptionsQ {
+	return NewPlaceTimetableExceptionsQ(q.db)
+}
+
+func (q PlaceTimetableExceptionsQ) Insert(ctx context.Context, in ...PlaceTimetableExceptionRow) error {
+	if len(in) == 0 {
+		return nil
+	}
+
+	ins := q.inserter.Columns("id", "place_id", "date", "start_min", "end_min", "reason")
+	for _, e := range in {
+		ins = ins.Values(e.ID, e.PlaceID, e.Date.Format(time.DateOnly), e.StartMin, e.EndMin, e.Reason)
+	}
+
+	query, args, err := ins.ToSql()
+	if err != nil {
+		return fmt.Errorf("build insert %s: %w", placeTimetableExceptionsTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+	return err
+}
+
+func (q PlaceTimetableExceptionsQ) Select(ctx context.Context) ([]PlaceTimetableExceptionRow, error) {
+	query, args, err := q.selector.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build select %s: %w", placeTimetableExceptionsTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []PlaceTimetableExceptionRow
+	for rows.Next() {
+		var e PlaceTimetableExceptionRow
+		if err := rows.Scan(&e.ID, &e.PlaceID, &e.Date, &e.StartMin, &e.EndMin, &e.Reason); err != nil {
+			return nil, err
+		}
+		out = append(out, e)
+	}
+	return out, rows.Err()
+}
+
+func (q PlaceTimetableExceptionsQ) Delete(ctx context.Context) error {
+	query, args, err := q.deleter.ToSql()
+	if err != nil {
+		return fmt.Errorf("build delete %s: %w", placeTimetableExceptionsTable, err)
+	}
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+	return err
+}
+
+func (q PlaceTimetableExceptionsQ) FilterPlaceID(placeID uuid.UUID) PlaceTimetableExceptionsQ {
+	q.selector = q.selector.Where(sq.Eq{"place_id": placeID})
+	q.deleter = q.deleter.Where(sq.Eq{"place_id": placeID})
+	q.counter = q.counter.Where(sq.Eq{"place_id": placeID})
+	return q
+}
+
+func (q PlaceTimetableExceptionsQ) FilterDate(date time.Time) PlaceTimetableExceptionsQ {
+	d := date.Format(time.DateOnly)
+	q.selector = q.selector.Where(sq.Eq{"date": d})
+	q.deleter = q.deleter.Where(sq.Eq{"date": d})
+	q.counter = q.counter.Where(sq.Eq{"date": d})
+	return q
+}
+
+func (q PlaceTimetableExceptionsQ) FilterDateFrom(from time.Time) PlaceTimetableExceptionsQ {
+	d := from.Format(time.DateOnly)
+	q.selector = q.selector.Where(sq.GtOrEq{"date": d})
+	q.deleter = q.deleter.Where(sq.GtOrEq{"date": d})
+	q.counter = q.counter.Where(sq.GtOrEq{"date": d})
+	return q
+}
+
+func (q PlaceTimetableExceptionsQ) FilterDateTo(to time.Time) PlaceTimetableExceptionsQ {
+	d := to.Format(time.DateOnly)
+	q.selector = q.selector.Where(sq.LtOrEq{"date": d})
+	q.deleter = q.deleter.Where(sq.LtOrEq{"date": d})
+	q.counter = q.counter.Where(sq.LtOrEq{"date": d})
+	return q
+}
+
+func (q PlaceTimetableExceptionsQ) OrderByDate(asc bool) PlaceTimetableExceptionsQ {
+	dir := "ASC"
+	if !asc {
+		dir = "DESC"
+	}
+
+	q.selector = q.selector.OrderBy("date "+dir, "start_min ASC NULLS FIRST")
+	return q
+}
+
+func (q PlaceTimetableExceptionsQ) Count(ctx context.Context) (uint64, error) {
+	query, args, err := q.counter.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("build count %s: %w", placeTimetableExceptionsTable, err)
+	}
+
+	var cnt uint64
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+	if err := row.Scan(&cnt); err != nil {
+		return 0, err
+	}
+	return cnt, nil
+}
@@ -0,0 +1,246 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+const placeWeeklyTimetablesTable = "place_weekly_timetables"
+
+type PlaceWeeklyTimetableRow struct {
+	ID         uuid.UUID     `storage:"id"`
+	PlaceID    uuid.UUID     `storage:"place_id"`
+	Name       string        `storage:"name"`
+	ValidFrom  sql.NullTime  `storage:"valid_from"`
+	ValidTo    sql.NullTime  `storage:"valid_to"`
+	TemplateID uuid.NullUUID `storage:"template_id"`
+	CreatedAt  time.Time     `storage:"created_at"`
+	UpdatedAt  time.Time     `storage:"updated_at"`
+}
+
+type PlaceWeeklyTimetablesQ struct {
+	db       *sql.DB
+	selector sq.SelectBuilder
+	updater  sq.UpdateBuilder
+	deleter  sq.DeleteBuilder
+	counter  sq.SelectBuilder
+}
+
+func NewPlaceWeeklyTimetablesQ(db *sql.DB) PlaceWeeklyTimetablesQ {
+	b := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	return PlaceWeeklyTimetablesQ{
+		db: db,
+		selector: b.Select(
+			"id",
+			"place_id",
+			"name",
+			"valid_from",
+			"valid_to",
+			"template_id",
+			"created_at",
+			"updated_at",
+		).From(placeWeeklyTimetablesTable),
+		updater: b.Update(placeWeeklyTimetablesTable),
+		deleter: b.Delete(placeWeeklyTimetablesTable),
+		counter: b.Select("COUNT(*) AS count").From(placeWeeklyTimetablesTable),
+	}
+}
+
+func (q PlaceWeeklyTimetablesQ) New() PlaceWeeklyTimetablesQ {
+	return NewPlaceWeeklyTimetablesQ(q.db)
+}
+
+// Upsert creates the named timetable of the place or updates its validity dates and template link,
+// and returns its id.
+func (q PlaceWeeklyTimetablesQ) Upsert(ctx context.Context, in PlaceWeeklyTimetableRow) (uuid.UUID, error) {
+	query := fmt.Sprintf(`
+		INSERT INTO %s (id, place_id, name, valid_from, valid_to, template_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (place_id, name) DO UPDATE
+		SET valid_from  = EXCLUDED.valid_from,
+		    valid_to    = EXCLUDED.valid_to,
+		    template_id = EXCLUDED.template_id,
+		    updated_at  = EXCLUDED.updated_at
+		RETURNING id
+	`, placeWeeklyTimetablesTable)
+
+	args := []any{
+		in.ID, in.PlaceID, in.Name,
+		nullDate(in.ValidFrom), nullDate(in.ValidTo),
+		in.TemplateID, in.CreatedAt, in.UpdatedAt,
+	}
+
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+
+	var id uuid.UUID
+	if err := row.Scan(&id); err != nil {
+		return uuid.Nil, err
+	}
+	return id, nil
+}
+
+func (q PlaceWeeklyTimetablesQ) Get(ctx context.Context) (PlaceWeeklyTimetableRow, error) {
+	query, args, err := q.selector.Limit(1).ToSql()
+	if err != nil {
+		return PlaceWeeklyTimetableRow{}, fmt.Errorf("build select %s: %w", placeWeeklyTimetablesTable, err)
+	}
+
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+
+	var out PlaceWeeklyTimetableRow
+	err = row.Scan(&out.ID, &out.PlaceID, &out.Name, &out.ValidFrom, &out.ValidTo, &out.TemplateID, &out.CreatedAt, &out.UpdatedAt)
+	return out, err
+}
+
+func (q PlaceWeeklyTimetablesQ) Select(ctx context.Context) ([]PlaceWeeklyTimetableRow, error) {
+	query, args, err := q.selector.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build select %s: %w", placeWeeklyTimetablesTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []PlaceWeeklyTimetableRow
+	for rows.Next() {
+		var t PlaceWeeklyTimetableRow
+		if err := rows.Scan(&t.ID, &t.PlaceID, &t.Name, &t.ValidFrom, &t.ValidTo, &t.TemplateID, &t.CreatedAt, &t.UpdatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, t)
+	}
+	return out, rows.Err()
+}
+
+func (q PlaceWeeklyTimetablesQ) Update(ctx context.Context, updatedAt time.Time) error {
+	q.updater = q.updater.Set("updated_at", updatedAt)
+
+	query, args, err := q.updater.ToSql()
+	if err != nil {
+		return fmt.Errorf("build update %s: %w", placeWeeklyTimetablesTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+	return err
+}
+
+func (q PlaceWeeklyTimetablesQ) UpdateTemplateID(templateID uuid.NullUUID) PlaceWeeklyTimetablesQ {
+	q.updater = q.updater.Set("template_id", templateID)
+	return q
+}
+
+func (q PlaceWeeklyTimetablesQ) Delete(ctx context.Context) error {
+	query, args, err := q.deleter.ToSql()
+	if err != nil {
+		return fmt.Errorf("build delete %s: %w", placeWeeklyTimetablesTable, err)
+	}
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+	return err
+}
+
+func (q PlaceWeeklyTimetablesQ) FilterPlaceID(placeID uuid.UUID) PlaceWeeklyTimetablesQ {
+	q.selector = q.selector.Where(sq.Eq{"place_id": placeID})
+	q.updater = q.updater.Where(sq.Eq{"place_id": placeID})
+	q.deleter = q.deleter.Where(sq.Eq{"place_id": placeID})
+	q.counter = q.counter.Where(sq.Eq{"place_id": placeID})
+	return q
+}
+
+func (q PlaceWeeklyTimetablesQ) FilterName(name string) PlaceWeeklyTimetablesQ {
+	q.selector = q.selector.Where(sq.Eq{"name": name})
+	q.updater = q.updater.Where(sq.Eq{"name": name})
+	q.deleter = q.deleter.Where(sq.Eq{"name": name})
+	q.counter = q.counter.Where(sq.Eq{"name": name})
+	return q
+}
+
+func (q PlaceWeeklyTimetablesQ) FilterTemplateID(templateID uuid.UUID) PlaceWeeklyTimetablesQ {
+	q.selector = q.selector.Where(sq.Eq{"template_id": templateID})
+	q.updater = q.updater.Where(sq.Eq{"template_id": templateID})
+	q.deleter = q.deleter.Where(sq.Eq{"template_id": templateID})
+	q.counter = q.counter.Where(sq.Eq{"template_id": templateID})
+	return q
+}
+
+// ForUpdate locks the selected timetables until the end of the transaction.
+func (q PlaceWeeklyTimetablesQ) ForUpdate() PlaceWeeklyTimetablesQ {
+	q.selector = q.selector.Suffix("FOR UPDATE")
+	return q
+}
+
+func (q PlaceWeeklyTimetablesQ) OrderByValidity() PlaceWeeklyTimetablesQ {
+	q.selector = q.selector.OrderBy("valid_from ASC NULLS FIRST", "valid_to ASC NULLS LAST", "name ASC")
+	return q
+}
+
+func (q PlaceWeeklyTimetablesQ) Count(ctx context.Context) (uint64, error) {
+	query, args, err := q.counter.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("build count %s: %w", placeWeeklyTimetablesTable, err)
+	}
+
+	var cnt uint64
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+	if err := row.Scan(&cnt); err != nil {
+		return 0, err
+	}
+	return cnt, nil
+}
+
+// activeWeeklyTimetable selects the id of the place timetable in force on the date given by dateExpr:
+// among the timetables whose validity covers the date the one that started last wins, a timetable
+// without dates is the fallback. The order is the one of models.ActiveTimetable, TestActiveTimetableRules
+// checks that both agree.
+func activeWeeklyTimetable(dateExpr string, args ...any) sq.SelectBuilder {
+	return sq.Select("wt.id").
+		From(placeWeeklyTimetablesTable+" wt").
+		Where("wt.place_id = p.id").
+		Where("(wt.valid_from IS NULL OR wt.valid_from <= "+dateExpr+")", args...).
+		Where("(wt.valid_to IS NULL OR wt.valid_to >= "+dateExpr+")", args...).
+		OrderBy("wt.valid_from DESC NULLS LAST", "wt.valid_to ASC NULLS LAST", "wt.name ASC").
+		Limit(1)
+}
+
+func nullDate(t sql.NullTime) any {
+	if !t.Valid {
+		return nil
+	}
+	return t.Time.Format(time.DateOnly)
+}
@@ -5,12 +5,15 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 
 	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/encoding/wkt"
 )
 
 const placesTable = "places"
@@ -26,8 +29,11 @@ type PlaceRow struct {
 	Point    orb.Point `storage:"Point"`
 	Address  string    `storage:"Address"`
 
-	Website sql.NullString `storage:"Website"`
-	Phone   sql.NullString `storage:"Phone"`
+	Website  sql.NullString `storage:"Website"`
+	Phone    sql.NullString `storage:"Phone"`
+	Timezone string         `storage:"timezone"`
+
+	AddressStatus string `storage:"address_status"`
 
 	CreatedAt time.Time `storage:"created_at"`
 	UpdatedAt time.Time `storage:"updated_at"`
@@ -39,6 +45,14 @@ type Place struct {
 	Name        string
 	Description string
 	Timetable   []PlaceTimetableRow
+
+	// Rank and Snippet are set only for full-text search queries.
+	Rank    sql.NullFloat64
+	Snippet sql.NullString
+	// Distance is set only when sorted by distance.
+	Distance sql.NullFloat64
+	// AddressDetails is the structured address in the requested locale, nil if the place has none.
+	AddressDetails *PlaceAddress
 }
 
 type PlacesQ struct {
@@ -48,6 +62,14 @@ type PlacesQ struct {
 	updater  sq.UpdateBuilder
 	deleter  sq.DeleteBuilder
 	counter  sq.SelectBuilder
+
+	search       *placeSearch
+	distanceFrom *orb.Point
+}
+
+type placeSearch struct {
+	text      string
+	highlight bool
 }
 
 func NewPlacesQ(db *sql.DB) PlacesQ {
@@ -67,6 +89,8 @@ func NewPlacesQ(db *sql.DB) PlacesQ {
 			"p.address",
 			"p.website",
 			"p.phone",
+			"p.timezone",
+			"p.address_status",
 			"p.created_at",
 			"p.updated_at",
 		).From(placesTable + " AS p"),
@@ -94,6 +118,8 @@ func scanPlaceRow(scanner interface{ Scan(dest ...any) error }) (PlaceRow, error
 		&p.Address,
 		&p.Website,
 		&p.Phone,
+		&p.Timezone,
+		&p.AddressStatus,
 		&p.CreatedAt,
 		&p.UpdatedAt,
 	); err != nil {
@@ -113,6 +139,10 @@ func scanPlaceWihDetails(scanner interface{ Scan(dest ...any) error }) (Place, e
 		locName   string
 		locDesc   string
 		ttJSON    []byte
+		rank      sql.NullFloat64
+		snippet   sql.NullString
+		distance  sql.NullFloat64
+		addrJSON  []byte
 	)
 
 	if err := scanner.Scan(
@@ -127,12 +157,18 @@ func scanPlaceWihDetails(scanner interface{ Scan(dest ...any) error }) (Place, e
 		&p.Address,
 		&p.Website,
 		&p.Phone,
+		&p.Timezone,
+		&p.AddressStatus,
 		&p.CreatedAt,
 		&p.UpdatedAt,
 		&locLocale,
 		&locName,
 		&locDesc,
 		&ttJSON, // ← агрегированное расписание
+		&rank,
+		&snippet,
+		&distance,
+		&addrJSON,
 	); err != nil {
 		return Place{}, err
 	}
@@ -146,12 +182,24 @@ func scanPlaceWihDetails(scanner interface{ Scan(dest ...any) error }) (Place, e
 		}
 	}
 
+	var addr *PlaceAddress
+	if len(addrJSON) > 0 {
+		addr = &PlaceAddress{}
+		if err := json.Unmarshal(addrJSON, addr); err != nil {
+			return Place{}, fmt.Errorf("unmarshal address: %w", err)
+		}
+	}
+
 	return Place{
-		PlaceRow:    p,
-		Locale:      locLocale,
-		Name:        locName,
-		Description: locDesc,
-		Timetable:   tt,
+		PlaceRow:       p,
+		Locale:         locLocale,
+		Name:           locName,
+		Description:    locDesc,
+		Timetable:      tt,
+		Rank:           rank,
+		Snippet:        snippet,
+		Distance:       distance,
+		AddressDetails: addr,
 	}, nil
 }
 
@@ -161,16 +209,18 @@ func (q PlacesQ) New() PlacesQ {
 
 func (q PlacesQ) Insert(ctx context.Context, in PlaceRow) error {
 	stmt := map[string]interface{}{
-		"id":         in.ID,
-		"city_id":    in.CityID,
-		"class":      in.Class,
-		"status":     in.Status,
-		"verified":   in.Verified,
-		"point":      sq.Expr("ST_SetSRID(ST_MakePoint(?, ?), 4326)::geography", in.Point[0], in.Point[1]),
-		"address":    in.Address,
-		"created_at": in.CreatedAt,
-		"updated_at": in.UpdatedAt,
-		"company_id": in.CompanyID,
+		"id":             in.ID,
+		"city_id":        in.CityID,
+		"class":          in.Class,
+		"status":         in.Status,
+		"verified":       in.Verified,
+		"point":          sq.Expr("ST_SetSRID(ST_MakePoint(?, ?), 4326)::geography", in.Point[0], in.Point[1]),
+		"address":        in.Address,
+		"timezone":       in.Timezone,
+		"created_at":     in.CreatedAt,
+		"address_status": in.AddressStatus,
+		"updated_at":     in.UpdatedAt,
+		"company_id":     in.CompanyID,
 	}
 
 	if in.CompanyID.Valid {
@@ -307,6 +357,11 @@ func (q PlacesQ) UpdatePhone(phone sql.NullString) PlacesQ {
 	return q
 }
 
+func (q PlacesQ) UpdateTimezone(timezone string) PlacesQ {
+	q.updater = q.updater.Set("timezone", timezone)
+	return q
+}
+
 func (q PlacesQ) Delete(ctx context.Context) error {
 	query, args, err := q.deleter.ToSql()
 	if err != nil {
@@ -349,6 +404,20 @@ func (q PlacesQ) FilterCompanyID(companyID ...uuid.UUID) PlacesQ {
 	return q
 }
 
+// FilterWithinBoundary keeps places inside the city or district boundary, none if the boundary is unknown.
+func (q PlacesQ) FilterWithinBoundary(boundaryID uuid.UUID) PlacesQ {
+	cond := sq.Expr(
+		"EXISTS (SELECT 1 FROM "+boundariesTable+" b WHERE b.id = ? AND ST_Covers(b.geom, p.point::geometry))",
+		boundaryID,
+	)
+
+	q.selector = q.selector.Where(cond)
+	q.counter = q.counter.Where(cond)
+	q.updater = q.updater.Where(cond)
+	q.deleter = q.deleter.Where(cond)
+	return q
+}
+
 func (q PlacesQ) FilterClass(codes ...string) PlacesQ {
 	if len(codes) == 0 {
 		return q
@@ -418,9 +487,19 @@ func (q PlacesQ) FilterWithinRadiusMeters(point orb.Point, radiusM uint64) Place
 	return q
 }
 
+// FilterWithinBBox keeps places inside the box, minLon greater than maxLon means the box crosses the antimeridian.
 func (q PlacesQ) FilterWithinBBox(minLon, minLat, maxLon, maxLat float64) PlacesQ {
-	env := sq.Expr("ST_MakeEnvelope(?, ?, ?, ?, 4326)", minLon, minLat, maxLon, maxLat)
-	cond := sq.Expr("ST_Within(p.point::geometry, ?)", env)
+	var cond sq.Sqlizer
+	if minLon > maxLon {
+		// разбиваем на две части по обе стороны от антимеридиана
+		cond = sq.Or{
+			sq.Expr("ST_Within(p.point::geometry, ST_MakeEnvelope(?, ?, 180, ?, 4326))", minLon, minLat, maxLat),
+			sq.Expr("ST_Within(p.point::geometry, ST_MakeEnvelope(-180, ?, ?, ?, 4326))", minLat, maxLon, maxLat),
+		}
+	} else {
+		env := sq.Expr("ST_MakeEnvelope(?, ?, ?, ?, 4326)", minLon, minLat, maxLon, maxLat)
+		cond = sq.Expr("ST_Within(p.point::geometry, ?)", env)
+	}
 
 	q.selector = q.selector.Where(cond)
 	q.counter = q.counter.Where(cond)
@@ -440,11 +519,33 @@ func (q PlacesQ) FilterWithinPolygonWKT(polyWKT string) PlacesQ {
 	return q
 }
 
+// FilterWithinArea keeps places inside the polygon or multipolygon.
+// Rings crossing the antimeridian have longitudes past ±180, so points are also matched against the area
+// shifted by 360°. The area is shifted rather than the point, so places_point_geom_idx is used for every branch.
+func (q PlacesQ) FilterWithinArea(area orb.Geometry) PlacesQ {
+	poly := sq.Expr("ST_SetSRID(ST_GeomFromText(?), 4326)", wkt.MarshalString(area))
+
+	conds := sq.Or{sq.Expr("ST_Within(p.point::geometry, ?)", poly)}
+	bound := area.Bound()
+	if bound.Max[0] > 180 {
+		conds = append(conds, sq.Expr("ST_Within(p.point::geometry, ST_Translate(?, -360, 0))", poly))
+	}
+	if bound.Min[0] < -180 {
+		conds = append(conds, sq.Expr("ST_Within(p.point::geometry, ST_Translate(?, 360, 0))", poly))
+	}
+
+	q.selector = q.selector.Where(conds)
+	q.counter = q.counter.Where(conds)
+	q.updater = q.updater.Where(conds)
+	q.deleter = q.deleter.Where(conds)
+	return q
+}
+
 func (q PlacesQ) FilterNameLike(name string) PlacesQ {
 	pattern := "%" + name + "%"
 	sub := sq.Select("1").
 		From(placeLocalizationTable+" pd").
-		Where("pd.company_id = p.id").
+		Where("pd.place_id = p.id").
 		Where("pd.name ILIKE ?", pattern)
 
 	q.selector = q.selector.Where(sq.Expr("EXISTS (?)", sub))
@@ -462,7 +563,123 @@ func (q PlacesQ) FilterAddressLike(addr string) PlacesQ {
 	return q
 }
 
-func (q PlacesQ) FilterTimetableBetween(start, end int) PlacesQ {
+// FilterCountryCode keeps places with an address in the country, the code is ISO 3166-1 alpha-2 in any case.
+func (q PlacesQ) FilterCountryCode(code string) PlacesQ {
+	return q.filterAddress(sq.Eq{"a.country_code": strings.ToLower(code)})
+}
+
+// FilterPostcode keeps places with an address with the postcode, spaces and case are ignored.
+// The expression must stay the same as in place_addresses_postcode_idx to use the index.
+func (q PlacesQ) FilterPostcode(postcode string) PlacesQ {
+	normalized := strings.ToUpper(strings.ReplaceAll(postcode, " ", ""))
+	return q.filterAddress(sq.Expr("upper(replace(a.postcode, ' ', '')) = ?", normalized))
+}
+
+// FilterStreetLike keeps places with an address on a street containing the text in any locale.
+func (q PlacesQ) FilterStreetLike(street string) PlacesQ {
+	return q.filterAddress(sq.Expr("a.street ILIKE ?", "%"+street+"%"))
+}
+
+func (q PlacesQ) filterAddress(cond sq.Sqlizer) PlacesQ {
+	sub := sq.Select("1").
+		From(placeAddressesTable + " a").
+		Where("a.place_id = p.id").
+		Where(cond)
+
+	q.selector = q.selector.Where(sq.Expr("EXISTS (?)", sub))
+	q.counter = q.counter.Where(sq.Expr("EXISTS (?)", sub))
+	return q
+}
+
+// searchConfigs are the text search configurations of place_search_config by locale,
+// any other locale is searched with searchDefaultConfig, without stemming.
+var searchConfigs = []struct{ locale, config string }{
+	{locale: "en", config: "english"},
+	{locale: "ru", config: "russian"},
+}
+
+const searchDefaultConfig = "simple"
+
+// SearchConfig is the text search configuration searchMatch uses for the locale,
+// it must be the one place_search_config returns for it.
+func SearchConfig(locale string) string {
+	for _, c := range searchConfigs {
+		if c.locale == locale {
+			return c.config
+		}
+	}
+	return searchDefaultConfig
+}
+
+// searchMatch matches a localization against the full-text query with the configuration of its locale.
+// Every branch has a constant tsquery, so place_i18n_search_idx can be used for it.
+func searchMatch(text string) sq.Or {
+	res := make(sq.Or, 0, len(searchConfigs)+1)
+	locales := make([]string, 0, len(searchConfigs))
+	for _, c := range searchConfigs {
+		res = append(res, sq.Expr(
+			fmt.Sprintf("(i.locale = '%s' AND i.search @@ websearch_to_tsquery('%s', ?))", c.locale, c.config),
+			text,
+		))
+		locales = append(locales, "'"+c.locale+"'")
+	}
+	res = append(res, sq.Expr(
+		fmt.Sprintf("(i.locale NOT IN (%s) AND i.search @@ websearch_to_tsquery('%s', ?))",
+			strings.Join(locales, ", "), searchDefaultConfig),
+		text,
+	))
+
+	return res
+}
+
+// searchConfigExpr is the text search configuration of the locale of the localization, as searchMatch picks it.
+func searchConfigExpr() string {
+	res := "CASE i.locale"
+	for _, c := range searchConfigs {
+		res += fmt.Sprintf(" WHEN '%s' THEN '%s'::regconfig", c.locale, c.config)
+	}
+	return res + fmt.Sprintf(" ELSE '%s'::regconfig END", searchDefaultConfig)
+}
+
+// searchQueryExpr is the full-text query in the configuration of the locale of the localization,
+// every branch is a constant tsquery as in searchMatch.
+func searchQueryExpr(text string) sq.Sqlizer {
+	res := "CASE i.locale"
+	args := make([]any, 0, len(searchConfigs)+1)
+	for _, c := range searchConfigs {
+		res += fmt.Sprintf(" WHEN '%s' THEN websearch_to_tsquery('%s', ?)", c.locale, c.config)
+		args = append(args, text)
+	}
+	res += fmt.Sprintf(" ELSE websearch_to_tsquery('%s', ?) END", searchDefaultConfig)
+	args = append(args, text)
+
+	return sq.Expr(res, args...)
+}
+
+// FilterSearch keeps places with at least one localization matching the full-text query text.
+// Every localization is matched with the text search configuration of its own locale.
+// With highlight the selected rows also get a snippet with the matched words marked.
+func (q PlacesQ) FilterSearch(text string, highlight bool) PlacesQ {
+	sub := sq.Select("1").
+		From(placeLocalizationTable + " i").
+		Where("i.place_id = p.id").
+		Where(searchMatch(text))
+
+	q.selector = q.selector.Where(sq.Expr("EXISTS (?)", sub))
+	q.counter = q.counter.Where(sq.Expr("EXISTS (?)", sub))
+
+	q.updater = q.updater.Where(sq.Expr("EXISTS (?)", sub))
+	q.deleter = q.deleter.Where(sq.Expr("EXISTS (?)", sub))
+
+	q.search = &placeSearch{text: text, highlight: highlight}
+	return q
+}
+
+// FilterTimetableBetween keeps places that are open at some moment of the week window [start, end).
+// The window is bound to the nearest calendar days starting from today in the place time zone, so for
+// every day it touches a date-specific exception (if any) takes precedence over the weekly timetable
+// in force on that day.
+func (q PlacesQ) FilterTimetableBetween(start, end int, now time.Time) PlacesQ {
 	const week = 7 * 24 * 60
 	norm := func(x int) int {
 		x %= week
@@ -476,27 +693,140 @@ func (q PlacesQ) FilterTimetableBetween(start, end int) PlacesQ {
 		return q
 	}
 
-	buildOverlap := func(alias string) sq.Sqlizer {
-		colS := alias + ".start_min"
-		colE := alias + ".end_min"
-		if s < e {
-			return sq.And{sq.Lt{colS: e}, sq.Gt{colE: s}}
-		}
-		return sq.Or{sq.Gt{colE: s}, sq.Lt{colS: e}}
+	// дата куска окна считается в часовом поясе места: ближайший день недели начала окна
+	// начиная с местного «сегодня», дальше по дню на кусок
+	const (
+		localToday = "(?::timestamptz AT TIME ZONE p.timezone)::date"
+		spanDate   = "(" + localToday + " + ((?::int - EXTRACT(DOW FROM " + localToday + ")::int + 7) % 7) + ?::int)"
+	)
+	now = now.UTC()
+	firstWeekday := s / (24 * 60)
+
+	cond := sq.Or{}
+	for _, span := range splitWeekWindow(s, e) {
+		dateArgs := []any{now, firstWeekday, now, span.dayOffset}
+
+		exception := sq.Select("1").
+			From(placeTimetableExceptionsTable+" pte").
+			Where("pte.place_id = p.id").
+			Where("pte.date = "+spanDate, dateArgs...)
+
+		exceptionOpen := exception.Where(sq.And{
+			sq.Lt{"pte.start_min": span.dayEnd},
+			sq.Gt{"pte.end_min": span.dayStart},
+		})
+
+		weekly := sq.Select("1").
+			From(placeTimetablesTable + " pt").
+			Where("pt.place_id = p.id").
+			Where(sq.Expr("pt.timetable_id = (?)", activeWeeklyTimetable(spanDate, dateArgs...))).
+			Where(sq.And{
+				sq.Lt{"pt.start_min": span.weekEnd},
+				sq.Gt{"pt.end_min": span.weekStart},
+			})
+
+		cond = append(cond, sq.Or{
+			sq.Expr("EXISTS (?)", exceptionOpen),
+			sq.And{
+				sq.Expr("NOT EXISTS (?)", exception),
+				sq.Expr("EXISTS (?)", weekly),
+			},
+		})
 	}
 
-	sub := sq.Select("1").
-		From(placeTimetablesTable + " pt").
-		Where("pt.company_id = p.id").
-		Where(buildOverlap("pt"))
+	q.selector = q.selector.Where(cond)
+	q.counter = q.counter.Where(cond)
+	q.updater = q.updater.Where(cond)
+	q.deleter = q.deleter.Where(cond)
+	return q
+}
 
-	q.selector = q.selector.Where(sq.Expr("EXISTS (?)", sub))
-	q.counter = q.counter.Where(sq.Expr("EXISTS (?)", sub))
-	q.updater = q.updater.Where(sq.Expr("EXISTS (?)", sub))
-	q.deleter = q.deleter.Where(sq.Expr("EXISTS (?)", sub))
+// FilterOpenAt keeps places that are open at the instant at. The instant is converted into
+// every place's local time using its IANA zone, so DST shifts are handled by postgres itself.
+// A date-specific exception for the local date takes precedence over the weekly timetable,
+// which in turn is the one in force on that local date.
+func (q PlacesQ) FilterOpenAt(at time.Time) PlacesQ {
+	const (
+		local   = "(?::timestamptz AT TIME ZONE p.timezone)"
+		dayMin  = "FLOOR(EXTRACT(EPOCH FROM " + local + "::time) / 60)::int"
+		weekMin = "(EXTRACT(DOW FROM " + local + ")::int * 1440 + " + dayMin + ")"
+	)
+	at = at.UTC()
+
+	exception := sq.Select("1").
+		From(placeTimetableExceptionsTable+" pte").
+		Where("pte.place_id = p.id").
+		Where("pte.date = "+local+"::date", at)
+
+	exceptionOpen := exception.
+		Where("pte.start_min <= "+dayMin, at).
+		Where("pte.end_min > "+dayMin, at)
+
+	weekly := sq.Select("1").
+		From(placeTimetablesTable+" pt").
+		Where("pt.place_id = p.id").
+		Where(sq.Expr("pt.timetable_id = (?)", activeWeeklyTimetable(local+"::date", at))).
+		Where("pt.start_min <= "+weekMin, at, at).
+		Where("pt.end_min > "+weekMin, at, at)
+
+	cond := sq.Or{
+		sq.Expr("EXISTS (?)", exceptionOpen),
+		sq.And{
+			sq.Expr("NOT EXISTS (?)", exception),
+			sq.Expr("EXISTS (?)", weekly),
+		},
+	}
+
+	q.selector = q.selector.Where(cond)
+	q.counter = q.counter.Where(cond)
+	q.updater = q.updater.Where(cond)
+	q.deleter = q.deleter.Where(cond)
 	return q
 }
 
+type windowSpan struct {
+	dayOffset          int // дней от первого куска окна
+	dayStart, dayEnd   int // минуты от начала суток
+	weekStart, weekEnd int // те же минуты в координатах недели
+}
+
+// splitWeekWindow cuts the week window [s, e) into per-day pieces, the first one is on the window's
+// starting weekday and every next one a day later.
+func splitWeekWindow(s, e int) []windowSpan {
+	const (
+		day  = 24 * 60
+		week = 7 * day
+	)
+
+	length := e - s
+	if length <= 0 {
+		length += week
+	}
+
+	var out []windowSpan
+	cur := s
+	for offset := 0; length > 0; offset++ {
+		dayStart := cur % day
+		take := day - dayStart
+		if take > length {
+			take = length
+		}
+
+		out = append(out, windowSpan{
+			dayOffset: offset,
+			dayStart:  dayStart,
+			dayEnd:    dayStart + take,
+			weekStart: cur,
+			weekEnd:   cur + take,
+		})
+
+		cur = (cur + take) % week
+		length -= take
+	}
+
+	return out
+}
+
 func (q PlacesQ) WithLocale(locale string) PlacesQ {
 	l := SanitizeLocale(locale)
 
@@ -505,7 +835,7 @@ func (q PlacesQ) WithLocale(locale string) PlacesQ {
 			`COALESCE(
 			   (SELECT i.`+field+`
 			      FROM `+placeLocalizationTable+` i
-			     WHERE i.company_id = p.id
+			     WHERE i.place_id = p.id
 			     ORDER BY CASE
 			       WHEN i.locale = ?     THEN 0
 			       WHEN i.locale = 'en'  THEN 1
@@ -526,22 +856,95 @@ func (q PlacesQ) WithLocale(locale string) PlacesQ {
 	return q
 }
 
+// WithTimetable attaches the intervals of the weekly timetable that is in force today in the place time zone.
 func (q PlacesQ) WithTimetable() PlacesQ {
+	tt := sq.Select("json_agg(json_build_object(" +
+		" 'id', pt.id, 'place_id', pt.place_id, 'timetable_id', pt.timetable_id," +
+		" 'start_min', pt.start_min, 'end_min', pt.end_min, 'wraps', pt.wraps" +
+		") ORDER BY pt.start_min) AS tt_json").
+		From(placeTimetablesTable + " pt").
+		Where("pt.place_id = p.id").
+		Where(sq.Expr("pt.timetable_id = (?)", activeWeeklyTimetable("(now() AT TIME ZONE p.timezone)::date")))
+
 	q.selector = q.selector.
-		LeftJoin("LATERAL (" +
-			"SELECT json_agg(json_build_object(" +
-			" 'id', pt.id, 'company_id', pt.company_id, 'start_min', pt.start_min, 'end_min', pt.end_min" +
-			") ORDER BY pt.start_min) AS tt_json " +
-			"FROM " + placeTimetablesTable + " pt WHERE pt.company_id = p.id" +
-			") tt ON TRUE").
+		JoinClause(sq.Expr("LEFT JOIN LATERAL (?) tt ON TRUE", tt)).
 		Column("COALESCE(tt.tt_json, '[]'::json) AS tt_json")
 	return q
 }
 
+// WithSearch attaches the rank (and the snippet, if requested) of the best matching localization.
+// A localization in the requested locale wins over the others, the rest are compared by rank.
+// Without FilterSearch both columns are NULL.
+func (q PlacesQ) WithSearch(locale string) PlacesQ {
+	if q.search == nil {
+		q.selector = q.selector.
+			Column("NULL::real AS search_rank").
+			Column("NULL::text AS search_snippet")
+		return q
+	}
+
+	snippet := "NULL::text"
+	if q.search.highlight {
+		snippet = "ts_headline(tq.cfg, i.name || ' ' || i.description, tq.query)"
+	}
+
+	match := sq.Select(
+		"ts_rank_cd(i.search, tq.query) AS rank",
+		snippet+" AS snippet",
+	).
+		From(placeLocalizationTable+" i").
+		JoinClause(sq.Expr(
+			"CROSS JOIN LATERAL (SELECT "+searchConfigExpr()+" AS cfg, ? AS query) tq",
+			searchQueryExpr(q.search.text),
+		)).
+		Where("i.place_id = p.id").
+		Where(searchMatch(q.search.text)).
+		OrderByClause("(i.locale = ?) DESC, rank DESC", SanitizeLocale(locale)).
+		Limit(1)
+
+	q.selector = q.selector.
+		JoinClause(sq.Expr("LEFT JOIN LATERAL (?) s ON TRUE", match)).
+		Column("s.rank AS search_rank").
+		Column("s.snippet AS search_snippet")
+	return q
+}
+
+// WithDistance attaches the distance in meters to the point set by OrderByDistance, NULL without it.
+func (q PlacesQ) WithDistance() PlacesQ {
+	if q.distanceFrom == nil {
+		q.selector = q.selector.Column("NULL::float8 AS distance_m")
+		return q
+	}
+
+	q.selector = q.selector.Column(sq.Expr(
+		"ST_Distance(p.point, ST_SetSRID(ST_MakePoint(?, ?), 4326)::geography) AS distance_m",
+		q.distanceFrom[0], q.distanceFrom[1],
+	))
+	return q
+}
+
+// WithAddress attaches the structured address in the locale, falling back to English and then to any other,
+// as WithLocale does. The column is NULL for a place without an address.
+func (q PlacesQ) WithAddress(locale string) PlacesQ {
+	addr := sq.Select("row_to_json(a) AS addr_json").
+		From(placeAddressesTable+" a").
+		Where("a.place_id = p.id").
+		OrderByClause("CASE WHEN a.locale = ? THEN 0 WHEN a.locale = 'en' THEN 1 ELSE 2 END", SanitizeLocale(locale)).
+		Limit(1)
+
+	q.selector = q.selector.
+		JoinClause(sq.Expr("LEFT JOIN LATERAL (?) pa ON TRUE", addr)).
+		Column("pa.addr_json")
+	return q
+}
+
 func (q PlacesQ) GetWithDetails(ctx context.Context, locale string) (Place, error) {
 	qq := q
 	qq = qq.WithLocale(locale)
 	qq = qq.WithTimetable()
+	qq = qq.WithSearch(locale)
+	qq = qq.WithDistance()
+	qq = qq.WithAddress(locale)
 
 	query, args, err := qq.selector.Limit(1).ToSql()
 	if err != nil {
@@ -561,6 +964,9 @@ func (q PlacesQ) SelectWithDetails(ctx context.Context, locale string) ([]Place,
 	qq := q
 	qq = qq.WithLocale(locale)
 	qq = qq.WithTimetable()
+	qq = qq.WithSearch(locale)
+	qq = qq.WithDistance()
+	qq = qq.WithAddress(locale)
 
 	query, args, err := qq.selector.ToSql()
 	if err != nil {
@@ -600,6 +1006,18 @@ func (q PlacesQ) OrderByCreatedAt(asc bool) PlacesQ {
 	return q
 }
 
+// OrderByRank sorts by full-text search relevance, it makes sense only together with FilterSearch.
+func (q PlacesQ) OrderByRank(asc bool) PlacesQ {
+	dir := "ASC"
+	if !asc {
+		dir = "DESC"
+	}
+
+	q.selector = q.selector.OrderBy("search_rank " + dir + " NULLS LAST")
+
+	return q
+}
+
 func (q PlacesQ) OrderByDistance(point orb.Point, asc bool) PlacesQ {
 	dir := "ASC"
 	if !asc {
@@ -610,9 +1028,234 @@ func (q PlacesQ) OrderByDistance(point orb.Point, asc bool) PlacesQ {
 		"ST_Distance(p.point, ST_SetSRID(ST_MakePoint(?, ?), 4326)::geography) "+dir,
 		point[0], point[1],
 	)
+	q.distanceFrom = &point
 	return q
 }
 
+// OrderByID breaks ties of the other orders, keyset pagination needs a total order.
+func (q PlacesQ) OrderByID(asc bool) PlacesQ {
+	dir := "ASC"
+	if !asc {
+		dir = "DESC"
+	}
+
+	q.selector = q.selector.OrderBy("p.id " + dir)
+
+	return q
+}
+
+// keysetOp is the comparison that keeps rows after the cursor for the sort direction.
+func keysetOp(asc bool) string {
+	if asc {
+		return ">"
+	}
+	return "<"
+}
+
+// AfterCreatedAt keeps the places following (createdAt, id) in the created_at order, the count is not affected.
+func (q PlacesQ) AfterCreatedAt(createdAt time.Time, id uuid.UUID, asc bool) PlacesQ {
+	q.selector = q.selector.Where("(p.created_at, p.id) "+keysetOp(asc)+" (?, ?)", createdAt, id)
+
+	return q
+}
+
+// AfterDistance keeps the places following (distance, id) in the order by distance to the point.
+func (q PlacesQ) AfterDistance(point orb.Point, distance float64, id uuid.UUID, asc bool) PlacesQ {
+	q.selector = q.selector.Where(
+		"(ST_Distance(p.point, ST_SetSRID(ST_MakePoint(?, ?), 4326)::geography), p.id) "+keysetOp(asc)+" (?, ?)",
+		point[0], point[1], distance, id,
+	)
+
+	return q
+}
+
+// AfterRank keeps the places following (rank, id) in the relevance order, it works only together with FilterSearch.
+func (q PlacesQ) AfterRank(rank float64, id uuid.UUID, asc bool) PlacesQ {
+	q.selector = q.selector.Where("(s.rank, p.id) "+keysetOp(asc)+" (?, ?)", rank, id)
+
+	return q
+}
+
+// MVTExtent is the size of a vector tile in its own integer coordinates.
+const MVTExtent = 4096
+
+// Tile renders the filtered places inside the web mercator tile z/x/y as a Mapbox Vector Tile
+// with a single "places" layer. Features carry id, class, status, verified and the localized name.
+func (q PlacesQ) Tile(ctx context.Context, locale string, z, x, y uint32) ([]byte, error) {
+	envelope := sq.Expr("ST_TileEnvelope(?, ?, ?)", z, x, y)
+
+	// буфер в 64 единицы тайла, чтобы значки на краях не обрезались соседними тайлами
+	features := q.WithLocale(locale).selector.
+		Where(sq.Expr("p.point::geometry && ST_Transform(ST_TileEnvelope(?, ?, ?, margin => 64.0 / ?), 4326)",
+			z, x, y, MVTExtent))
+
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select().
+		Column(sq.Expr("COALESCE(ST_AsMVT(t, 'places', ?, 'geom'), ''::bytea)", MVTExtent)).
+		FromSelect(
+			sq.Select("f.id::text AS id", "f.class", "f.status", "f.verified", "f.loc_name AS name").
+				Column(sq.Expr(
+					"ST_AsMVTGeom(ST_Transform(ST_SetSRID(ST_MakePoint(f.point_lon, f.point_lat), 4326), 3857), ?, ?, 64, true) AS geom",
+					envelope, MVTExtent,
+				)).
+				FromSelect(features, "f"),
+			"t",
+		).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building tile query for %s: %w", placesTable, err)
+	}
+
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+
+	var tile []byte
+	if err := row.Scan(&tile); err != nil {
+		return nil, err
+	}
+	return tile, nil
+}
+
+type PlaceCluster struct {
+	CellX    int64
+	CellY    int64
+	Centroid orb.Point
+	Count    uint64
+	PlaceIDs []uuid.UUID
+}
+
+// Clusters groups the filtered places into square cells of cell degrees and returns at most limit
+// of the most populated cells. Cells with at most sampleMax places also carry the ids of their places.
+func (q PlacesQ) Clusters(ctx context.Context, cell float64, sampleMax, limit uint64) ([]PlaceCluster, error) {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select().
+		Column(sq.Expr("floor(f.point_lon / ?)::bigint AS cell_x", cell)).
+		Column(sq.Expr("floor(f.point_lat / ?)::bigint AS cell_y", cell)).
+		Column("AVG(f.point_lon)").
+		Column("AVG(f.point_lat)").
+		Column("COUNT(*)").
+		Column(sq.Expr("CASE WHEN COUNT(*) <= ? THEN array_agg(f.id::text ORDER BY f.id) END", sampleMax)).
+		FromSelect(q.selector, "f").
+		GroupBy("cell_x", "cell_y").
+		OrderBy("COUNT(*) DESC", "cell_x", "cell_y").
+		Limit(limit).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building clusters query for %s: %w", placesTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []PlaceCluster
+	for rows.Next() {
+		var (
+			c        PlaceCluster
+			lon, lat float64
+			ids      pq.StringArray
+		)
+		if err := rows.Scan(&c.CellX, &c.CellY, &lon, &lat, &c.Count, &ids); err != nil {
+			return nil, fmt.Errorf("scan place cluster: %w", err)
+		}
+		c.Centroid = orb.Point{lon, lat}
+
+		for _, id := range ids {
+			placeID, err := uuid.Parse(id)
+			if err != nil {
+				return nil, fmt.Errorf("parse place id %q in cluster: %w", id, err)
+			}
+			c.PlaceIDs = append(c.PlaceIDs, placeID)
+		}
+
+		out = append(out, c)
+	}
+	return out, rows.Err()
+}
+
+type PlaceFacetCount struct {
+	Value string
+	Count uint64
+}
+
+// FacetClasses counts the filtered places per class, a place counts for its class and every ancestor of it.
+func (q PlacesQ) FacetClasses(ctx context.Context) ([]PlaceFacetCount, error) {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("c.code", "COUNT(*)").
+		FromSelect(q.selector, "f").
+		Join(classesTable+" pc ON pc.code = f.class").
+		Join(classesTable+" c ON c.path @> pc.path").
+		GroupBy("c.code").
+		OrderBy("COUNT(*) DESC", "c.code").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building class facet query for %s: %w", placesTable, err)
+	}
+
+	return q.facetCounts(ctx, query, args)
+}
+
+// FacetStatuses counts the filtered places per status.
+func (q PlacesQ) FacetStatuses(ctx context.Context) ([]PlaceFacetCount, error) {
+	return q.facetBy(ctx, "f.status::text")
+}
+
+// FacetVerified counts the filtered places per verified flag, the values are "true" and "false".
+func (q PlacesQ) FacetVerified(ctx context.Context) ([]PlaceFacetCount, error) {
+	return q.facetBy(ctx, "f.verified::text")
+}
+
+func (q PlacesQ) facetBy(ctx context.Context, column string) ([]PlaceFacetCount, error) {
+	query, args, err := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select(column+" AS value", "COUNT(*)").
+		FromSelect(q.selector, "f").
+		GroupBy("value").
+		OrderBy("COUNT(*) DESC", "value").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("building facet query for %s: %w", placesTable, err)
+	}
+
+	return q.facetCounts(ctx, query, args)
+}
+
+func (q PlacesQ) facetCounts(ctx context.Context, query string, args []any) ([]PlaceFacetCount, error) {
+	var (
+		rows *sql.Rows
+		err  error
+	)
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []PlaceFacetCount
+	for rows.Next() {
+		var c PlaceFacetCount
+		if err := rows.Scan(&c.Value, &c.Count); err != nil {
+			return nil, fmt.Errorf("scan place facet: %w", err)
+		}
+		out = append(out, c)
+	}
+	return out, rows.Err()
+}
+
 func (q PlacesQ) Count(ctx context.Context) (uint64, error) {
 	query, args, err := q.counter.ToSql()
 	if err != nil {
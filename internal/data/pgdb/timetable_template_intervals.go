@@ -0,0 +1,118 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+const timetableTemplateIntervalsTable = "timetable_template_intervals"
+
+type TimetableTemplateIntervalRow struct {
+	ID         uuid.UUID `storage:"id"`
+	TemplateID uuid.UUID `storage:"template_id"`
+	StartMin   int       `storage:"start_min"`
+	EndMin     int       `storage:"end_min"`
+	Wraps      bool      `storage:"wraps"`
+}
+
+type TimetableTemplateIntervalsQ struct {
+	db       *sql.DB
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+	deleter  sq.DeleteBuilder
+}
+
+func NewTimetableTemplateIntervalsQ(db *sql.DB) TimetableTemplateIntervalsQ {
+	b := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	return TimetableTemplateIntervalsQ{
+		db: db,
+		selector: b.Select(
+			"id",
+			"template_id",
+			"start_min",
+			"end_min",
+			"wraps",
+		).From(timetableTemplateIntervalsTable),
+		inserter: b.Insert(timetableTemplateIntervalsTable),
+		deleter:  b.Delete(timetableTemplateIntervalsTable),
+	}
+}
+
+func (q TimetableTemplateIntervalsQ) New() TimetableTemplateIntervalsQ {
+	return NewTimetableTemplateIntervalsQ(q.db)
+}
+
+func (q TimetableTemplateIntervalsQ) Insert(ctx context.Context, in ...TimetableTemplateIntervalRow) error {
+	if len(in) == 0 {
+		return nil
+	}
+
+	ins := q.inserter.Columns("id", "template_id", "start_min", "end_min", "wraps")
+	for _, t := range in {
+		ins = ins.Values(t.ID, t.TemplateID, t.StartMin, t.EndMin, t.Wraps)
+	}
+
+	query, args, err := ins.ToSql()
+	if err != nil {
+		return fmt.Errorf("build insert %s: %w", timetableTemplateIntervalsTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+	return err
+}
+
+func (q TimetableTemplateIntervalsQ) Select(ctx context.Context) ([]TimetableTemplateIntervalRow, error) {
+	query, args, err := q.selector.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build select %s: %w", timetableTemplateIntervalsTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []TimetableTemplateIntervalRow
+	for rows.Next() {
+		var t TimetableTemplateIntervalRow
+		if err := rows.Scan(&t.ID, &t.TemplateID, &t.StartMin, &t.EndMin, &t.Wraps); err != nil {
+			return nil, err
+		}
+		out = append(out, t)
+	}
+	return out, rows.Err()
+}
+
+func (q TimetableTemplateIntervalsQ) Delete(ctx context.Context) error {
+	query, args, err := q.deleter.ToSql()
+	if err != nil {
+		return fmt.Errorf("build delete %s: %w", timetableTemplateIntervalsTable, err)
+	}
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+	return err
+}
+
+func (q TimetableTemplateIntervalsQ) FilterTemplateID(templateID ...uuid.UUID) TimetableTemplateIntervalsQ {
+	q.selector = q.selector.Where(sq.Eq{"template_id": templateID})
+	q.deleter = q.deleter.Where(sq.Eq{"template_id": templateID})
+	return q
+}
@@ -0,0 +1,206 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+const timetableTemplatesTable = "timetable_templates"
+
+type TimetableTemplateRow struct {
+	ID        uuid.UUID `storage:"id"`
+	CompanyID uuid.UUID `storage:"company_id"`
+	Name      string    `storage:"name"`
+	CreatedAt time.Time `storage:"created_at"`
+	UpdatedAt time.Time `storage:"updated_at"`
+}
+
+type TimetableTemplatesQ struct {
+	db       *sql.DB
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+	updater  sq.UpdateBuilder
+	deleter  sq.DeleteBuilder
+	counter  sq.SelectBuilder
+}
+
+func NewTimetableTemplatesQ(db *sql.DB) TimetableTemplatesQ {
+	b := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+	return TimetableTemplatesQ{
+		db: db,
+		selector: b.Select(
+			"id",
+			"company_id",
+			"name",
+			"created_at",
+			"updated_at",
+		).From(timetableTemplatesTable),
+		inserter: b.Insert(timetableTemplatesTable),
+		updater:  b.Update(timetableTemplatesTable),
+		deleter:  b.Delete(timetableTemplatesTable),
+		counter:  b.Select("COUNT(*) AS count").From(timetableTemplatesTable),
+	}
+}
+
+func (q TimetableTemplatesQ) New() TimetableTemplatesQ { return NewTimetableTemplatesQ(q.db) }
+
+func (q TimetableTemplatesQ) Insert(ctx context.Context, in TimetableTemplateRow) error {
+	query, args, err := q.inserter.
+		Columns("id", "company_id", "name", "created_at", "updated_at").
+		Values(in.ID, in.CompanyID, in.Name, in.CreatedAt, in.UpdatedAt).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build insert %s: %w", timetableTemplatesTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+	return err
+}
+
+func (q TimetableTemplatesQ) Get(ctx context.Context) (TimetableTemplateRow, error) {
+	query, args, err := q.selector.Limit(1).ToSql()
+	if err != nil {
+		return TimetableTemplateRow{}, fmt.Errorf("build select %s: %w", timetableTemplatesTable, err)
+	}
+
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+
+	var out TimetableTemplateRow
+	err = row.Scan(&out.ID, &out.CompanyID, &out.Name, &out.CreatedAt, &out.UpdatedAt)
+	return out, err
+}
+
+func (q TimetableTemplatesQ) Select(ctx context.Context) ([]TimetableTemplateRow, error) {
+	query, args, err := q.selector.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build select %s: %w", timetableTemplatesTable, err)
+	}
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = q.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []TimetableTemplateRow
+	for rows.Next() {
+		var t TimetableTemplateRow
+		if err := rows.Scan(&t.ID, &t.CompanyID, &t.Name, &t.CreatedAt, &t.UpdatedAt); err != nil {
+			return nil, err
+		}
+		out = append(out, t)
+	}
+	return out, rows.Err()
+}
+
+func (q TimetableTemplatesQ) Update(ctx context.Context, updatedAt time.Time) error {
+	q.updater = q.updater.Set("updated_at", updatedAt)
+
+	query, args, err := q.updater.ToSql()
+	if err != nil {
+		return fmt.Errorf("build update %s: %w", timetableTemplatesTable, err)
+	}
+
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+	return err
+}
+
+func (q TimetableTemplatesQ) UpdateName(name string) TimetableTemplatesQ {
+	q.updater = q.updater.Set("name", name)
+	return q
+}
+
+func (q TimetableTemplatesQ) Delete(ctx context.Context) error {
+	query, args, err := q.deleter.ToSql()
+	if err != nil {
+		return fmt.Errorf("build delete %s: %w", timetableTemplatesTable, err)
+	}
+	if tx, ok := TxFromCtx(ctx); ok {
+		_, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		_, err = q.db.ExecContext(ctx, query, args...)
+	}
+	return err
+}
+
+func (q TimetableTemplatesQ) FilterID(id uuid.UUID) TimetableTemplatesQ {
+	q.selector = q.selector.Where(sq.Eq{"id": id})
+	q.updater = q.updater.Where(sq.Eq{"id": id})
+	q.deleter = q.deleter.Where(sq.Eq{"id": id})
+	q.counter = q.counter.Where(sq.Eq{"id": id})
+	return q
+}
+
+func (q TimetableTemplatesQ) FilterCompanyID(companyID uuid.UUID) TimetableTemplatesQ {
+	q.selector = q.selector.Where(sq.Eq{"company_id": companyID})
+	q.updater = q.updater.Where(sq.Eq{"company_id": companyID})
+	q.deleter = q.deleter.Where(sq.Eq{"company_id": companyID})
+	q.counter = q.counter.Where(sq.Eq{"company_id": companyID})
+	return q
+}
+
+func (q TimetableTemplatesQ) FilterName(name string) TimetableTemplatesQ {
+	q.selector = q.selector.Where(sq.Eq{"name": name})
+	q.updater = q.updater.Where(sq.Eq{"name": name})
+	q.deleter = q.deleter.Where(sq.Eq{"name": name})
+	q.counter = q.counter.Where(sq.Eq{"name": name})
+	return q
+}
+
+func (q TimetableTemplatesQ) OrderByName(asc bool) TimetableTemplatesQ {
+	dir := "ASC"
+	if !asc {
+		dir = "DESC"
+	}
+
+	q.selector = q.selector.OrderBy("name " + dir)
+	return q
+}
+
+func (q TimetableTemplatesQ) Page(limit, offset uint64) TimetableTemplatesQ {
+	q.selector = q.selector.Limit(limit).Offset(offset)
+	return q
+}
+
+func (q TimetableTemplatesQ) Count(ctx context.Context) (uint64, error) {
+	query, args, err := q.counter.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("build count %s: %w", timetableTemplatesTable, err)
+	}
+
+	var cnt uint64
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = q.db.QueryRowContext(ctx, query, args...)
+	}
+	if err := row.Scan(&cnt); err != nil {
+		return 0, err
+	}
+	return cnt, nil
+}
@@ -0,0 +1,47 @@
+package data
+
+import (
+	"context"
+	"time"
+
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+func (d Database) ClaimPlaceAddressJobs(ctx context.Context, now, lease time.Time, limit uint64) ([]models.PlaceAddressJob, error) {
+	rows, err := d.sql.places.New().ClaimAddressJobs(ctx, now, lease, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]models.PlaceAddressJob, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, models.PlaceAddressJob{
+			PlaceID:  row.ID,
+			Point:    row.Point,
+			Address:  row.Address,
+			Attempts: row.Attempts,
+			Locales:  row.Locales,
+			Lease:    row.Lease,
+		})
+	}
+
+	return res, nil
+}
+
+// SetPlaceAddressStatus stores the state of the address lookup, with a non-zero lease only for a place
+// still claimed with it. It reports whether the place was updated.
+func (d Database) SetPlaceAddressStatus(
+	ctx context.Context,
+	placeID uuid.UUID,
+	lease time.Time,
+	status string,
+	attempts int,
+	retryAt time.Time,
+) (bool, error) {
+	return d.sql.places.New().SetAddressStatus(ctx, placeID, lease, status, attempts, retryAt)
+}
+
+func (d Database) FillPlaceAddress(ctx context.Context, placeID uuid.UUID, address string) error {
+	return d.sql.places.New().FillAddress(ctx, placeID, address)
+}
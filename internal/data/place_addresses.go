@@ -0,0 +1,43 @@
+package data
+
+import (
+	"context"
+
+	"github.com/chains-lab/places-svc/internal/data/pgdb"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+func (d Database) UpsertPlaceAddress(ctx context.Context, addr models.PlaceAddress) error {
+	return d.sql.addresses.Upsert(ctx, pgdb.PlaceAddress{
+		PlaceID:     addr.PlaceID,
+		Locale:      addr.Locale,
+		Formatted:   addr.Formatted,
+		Country:     addr.Country,
+		CountryCode: addr.CountryCode,
+		State:       addr.State,
+		City:        addr.City,
+		Suburb:      addr.Suburb,
+		Street:      addr.Street,
+		HouseNumber: addr.HouseNumber,
+		Postcode:    addr.Postcode,
+	})
+}
+
+func (d Database) GetPlaceAddresses(ctx context.Context, placeID uuid.UUID) ([]models.PlaceAddress, error) {
+	schemas, err := d.sql.addresses.New().FilterPlaceID(placeID).OrderByLocale(true).Select(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]models.PlaceAddress, 0, len(schemas))
+	for _, schema := range schemas {
+		res = append(res, addressFromDB(schema))
+	}
+
+	return res, nil
+}
+
+func (d Database) DeletePlaceAddresses(ctx context.Context, placeID uuid.UUID) error {
+	return d.sql.addresses.New().FilterPlaceID(placeID).Delete(ctx)
+}
@@ -3,6 +3,7 @@ package data
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"time"
 
 	"github.com/chains-lab/places-svc/internal/data/pgdb"
@@ -33,12 +34,221 @@ func (d Database) FilterPlaces(
 	locale string,
 	filter place.FilterParams,
 	sort place.SortParams,
-	page, size uint64,
+	page place.PageParams,
 ) (models.PlacesCollection, error) {
-	limit, offset := pagi.PagConvert(page, size)
+	limit, offset := pagi.PagConvert(page.Page, page.Size)
 
-	query := d.sql.places.New()
+	query := placesFilterQuery(d.sql.places.New(), filter)
+
+	var total uint64
+	if !page.SkipTotal {
+		var err error
+		total, err = query.Count(ctx)
+		if err != nil {
+			return models.PlacesCollection{}, err
+		}
+	}
+
+	query = query.Page(limit, offset)
+
+	if sort.ByCreatedAt != nil {
+		query = query.OrderByCreatedAt(*sort.ByCreatedAt)
+	}
+	if sort.ByDistance != nil && filter.Location != nil {
+		query = query.OrderByDistance(filter.Location.Point, *sort.ByDistance)
+	}
+	if sort.ByRank != nil && filter.Search != nil {
+		query = query.OrderByRank(*sort.ByRank)
+	}
+
+	rows, err := query.SelectWithDetails(ctx, locale)
+	if err != nil {
+		return models.PlacesCollection{}, err
+	}
+
+	collection := make([]models.Place, 0, len(rows))
+	for _, row := range rows {
+		collection = append(collection, placeSchemaToModel(row))
+	}
+
+	return models.PlacesCollection{
+		Data:         collection,
+		Page:         page.Page,
+		Size:         page.Size,
+		Total:        total,
+		TotalSkipped: page.SkipTotal,
+	}, nil
+}
+
+func (d Database) FilterPlacesByCursor(
+	ctx context.Context,
+	locale string,
+	filter place.FilterParams,
+	order place.CursorOrder,
+	after *place.Cursor,
+	size uint64,
+	skipTotal bool,
+) (models.PlacesCollection, error) {
+	query := placesFilterQuery(d.sql.places.New(), filter)
+
+	var total uint64
+	if !skipTotal {
+		var err error
+		total, err = query.Count(ctx)
+		if err != nil {
+			return models.PlacesCollection{}, err
+		}
+	}
+
+	switch order.By {
+	case place.CursorByCreatedAt:
+		query = query.OrderByCreatedAt(order.Asc)
+		if after != nil {
+			query = query.AfterCreatedAt(after.CreatedAt, after.ID, order.Asc)
+		}
+	case place.CursorByDistance:
+		query = query.OrderByDistance(filter.Location.Point, order.Asc)
+		if after != nil {
+			query = query.AfterDistance(filter.Location.Point, after.Value, after.ID, order.Asc)
+		}
+	case place.CursorByRank:
+		query = query.OrderByRank(order.Asc)
+		if after != nil {
+			query = query.AfterRank(after.Value, after.ID, order.Asc)
+		}
+	default:
+		return models.PlacesCollection{}, fmt.Errorf("unknown cursor order %q", order.By)
+	}
+
+	// одна лишняя строка показывает, есть ли следующая страница
+	rows, err := query.OrderByID(order.Asc).Page(size+1, 0).SelectWithDetails(ctx, locale)
+	if err != nil {
+		return models.PlacesCollection{}, err
+	}
+
+	var next string
+	if uint64(len(rows)) > size {
+		rows = rows[:size]
+		last := rows[len(rows)-1]
+
+		c := place.Cursor{By: order.By, Asc: order.Asc, ID: last.ID}
+		switch order.By {
+		case place.CursorByCreatedAt:
+			c.CreatedAt = last.CreatedAt
+		case place.CursorByDistance:
+			c.Value = last.Distance.Float64
+		case place.CursorByRank:
+			c.Value = last.Rank.Float64
+		}
+		next = c.Encode()
+	}
+
+	collection := make([]models.Place, 0, len(rows))
+	for _, row := range rows {
+		collection = append(collection, placeSchemaToModel(row))
+	}
+
+	return models.PlacesCollection{
+		Data:         collection,
+		Size:         size,
+		Total:        total,
+		TotalSkipped: skipTotal,
+		NextCursor:   next,
+	}, nil
+}
+
+func (d Database) PlacesTile(ctx context.Context, locale string, filter place.FilterParams, z, x, y uint32) ([]byte, error) {
+	return placesFilterQuery(d.sql.places.New(), filter).Tile(ctx, locale, z, x, y)
+}
+
+func (d Database) ClusterPlaces(
+	ctx context.Context,
+	filter place.FilterParams,
+	cell float64,
+	sampleMax, limit uint64,
+) ([]models.PlaceCluster, error) {
+	rows, err := placesFilterQuery(d.sql.places.New(), filter).Clusters(ctx, cell, sampleMax, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]models.PlaceCluster, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, models.PlaceCluster{
+			CellX:    row.CellX,
+			CellY:    row.CellY,
+			Centroid: row.Centroid,
+			Count:    row.Count,
+			PlaceIDs: row.PlaceIDs,
+		})
+	}
+
+	return res, nil
+}
+
+func (d Database) CountPlaces(ctx context.Context, filter place.FilterParams) (uint64, error) {
+	return placesFilterQuery(d.sql.places.New(), filter).Count(ctx)
+}
+
+func (d Database) PlaceFacetCounts(
+	ctx context.Context,
+	filter place.FilterParams,
+	facet string,
+) ([]models.PlaceFacetCount, error) {
+	query := placesFilterQuery(d.sql.places.New(), filter)
+
+	var (
+		rows []pgdb.PlaceFacetCount
+		err  error
+	)
+	switch facet {
+	case place.FacetClass:
+		rows, err = query.FacetClasses(ctx)
+	case place.FacetStatus:
+		rows, err = query.FacetStatuses(ctx)
+	case place.FacetVerified:
+		rows, err = query.FacetVerified(ctx)
+	default:
+		return nil, fmt.Errorf("unknown place facet %q", facet)
+	}
+	if err != nil {
+		return nil, err
+	}
 
+	res := make([]models.PlaceFacetCount, 0, len(rows))
+	for _, row := range rows {
+		res = append(res, models.PlaceFacetCount{
+			Value: row.Value,
+			Count: row.Count,
+		})
+	}
+
+	return res, nil
+}
+
+// exportBatchSize is the number of places fetched from the export cursor at a time.
+const exportBatchSize = 500
+
+func (d Database) ExportPlaces(ctx context.Context, filter place.FilterParams, fn func(models.PlaceExport) error) error {
+	return placesFilterQuery(d.sql.places.New(), filter).Export(ctx, exportBatchSize, func(row pgdb.PlaceExport) error {
+		p := detailsFromDB(row.PlaceRow)
+		p.Timezone = row.Timezone
+
+		locales := make([]models.PlaceLocale, 0, len(row.Locales))
+		for _, loc := range row.Locales {
+			locales = append(locales, localeFromDB(loc))
+		}
+
+		return fn(models.PlaceExport{
+			PlaceDetails: p,
+			Locales:      locales,
+			Timetable:    timetableFromDB(row.Timetable),
+		})
+	})
+}
+
+// placesFilterQuery applies the place filters shared by listing and clustering.
+func placesFilterQuery(query pgdb.PlacesQ, filter place.FilterParams) pgdb.PlacesQ {
 	if filter.Classes != nil && len(filter.Classes) > 0 {
 		query = query.FilterClass(filter.Classes...)
 	}
@@ -51,6 +261,9 @@ func (d Database) FilterPlaces(
 	if filter.CompanyID != nil {
 		query = query.FilterCompanyID(*filter.CompanyID)
 	}
+	if filter.DistrictID != nil {
+		query = query.FilterWithinBoundary(*filter.DistrictID)
+	}
 	if filter.Verified != nil {
 		query = query.FilterVerified(*filter.Verified)
 	}
@@ -60,43 +273,70 @@ func (d Database) FilterPlaces(
 	if filter.Address != nil {
 		query = query.FilterAddressLike(*filter.Address)
 	}
+	if filter.CountryCode != nil {
+		query = query.FilterCountryCode(*filter.CountryCode)
+	}
+	if filter.Postcode != nil {
+		query = query.FilterPostcode(*filter.Postcode)
+	}
+	if filter.Street != nil {
+		query = query.FilterStreetLike(*filter.Street)
+	}
+	if filter.Search != nil {
+		query = query.FilterSearch(filter.Search.Query, filter.Search.Highlight)
+	}
 	if filter.Time != nil {
-		query = query.FilterTimetableBetween(filter.Time.From.ToNumberMinutes(), filter.Time.To.ToNumberMinutes())
+		query = query.FilterTimetableBetween(
+			filter.Time.From.ToNumberMinutes(),
+			filter.Time.To.ToNumberMinutes(),
+			time.Now().UTC(),
+		)
+	}
+	if filter.OpenAt != nil {
+		query = query.FilterOpenAt(*filter.OpenAt)
 	}
 	if filter.Location != nil {
 		query = query.FilterWithinRadiusMeters(filter.Location.Point, filter.Location.RadiusM)
 	}
-
-	total, err := query.Count(ctx)
-	if err != nil {
-		return models.PlacesCollection{}, err
+	if filter.BBox != nil {
+		query = query.FilterWithinBBox(filter.BBox.MinLon, filter.BBox.MinLat, filter.BBox.MaxLon, filter.BBox.MaxLat)
+	}
+	if filter.Area != nil {
+		query = query.FilterWithinArea(filter.Area)
 	}
 
-	query = query.Page(limit, offset)
+	return query
+}
 
-	if sort.ByCreatedAt != nil {
-		query = query.OrderByCreatedAt(*sort.ByCreatedAt)
-	}
-	if sort.ByDistance != nil && filter.Location != nil {
-		query = query.OrderByDistance(filter.Location.Point, *sort.ByDistance)
+func (d Database) SuggestPlaces(ctx context.Context, locale string, params place.SuggestParams) ([]models.PlaceSuggestion, error) {
+	query := d.sql.suggests.New().
+		Match(params.Query).
+		Locale(locale).
+		Limit(params.Limit)
+	if params.Point != nil {
+		query = query.Near(*params.Point)
 	}
 
-	rows, err := query.SelectWithDetails(ctx, locale)
+	rows, err := query.Select(ctx)
 	if err != nil {
-		return models.PlacesCollection{}, err
+		return nil, err
 	}
 
-	collection := make([]models.Place, 0, len(rows))
+	res := make([]models.PlaceSuggestion, 0, len(rows))
 	for _, row := range rows {
-		collection = append(collection, placeSchemaToModel(row))
+		s := models.PlaceSuggestion{
+			ID:     row.PlaceID,
+			Class:  row.Class,
+			Locale: row.Locale,
+			Name:   row.Name,
+		}
+		if row.Distance.Valid {
+			s.DistanceM = &row.Distance.Float64
+		}
+		res = append(res, s)
 	}
 
-	return models.PlacesCollection{
-		Data:  collection,
-		Page:  page,
-		Size:  size,
-		Total: total,
-	}, nil
+	return res, nil
 }
 
 func (d Database) PlaceExists(ctx context.Context, placeID uuid.UUID) (bool, error) {
@@ -133,6 +373,9 @@ func (d Database) UpdatePlace(ctx context.Context, placeID uuid.UUID, params pla
 			query = query.UpdateWebsite(sql.NullString{String: *params.Website, Valid: true})
 		}
 	}
+	if params.Timezone != nil {
+		query = query.UpdateTimezone(*params.Timezone)
+	}
 
 	return query.FilterID(placeID).Update(ctx, updatedAt)
 }
@@ -158,8 +401,11 @@ func placeModelToSchema(model models.PlaceDetails) pgdb.PlaceRow {
 		Verified:  model.Verified,
 		Point:     model.Point,
 		Address:   model.Address,
+		Timezone:  model.Timezone,
 		CreatedAt: model.CreatedAt,
 		UpdatedAt: model.UpdatedAt,
+
+		AddressStatus: model.AddressStatus,
 	}
 	if model.CompanyID != nil {
 		res.CompanyID = uuid.NullUUID{UUID: *model.CompanyID, Valid: true}
@@ -183,8 +429,11 @@ func placeDetailsSchemaToModel(schema pgdb.PlaceRow) models.Place {
 		Verified:  schema.Verified,
 		Point:     schema.Point,
 		Address:   schema.Address,
+		Timezone:  schema.Timezone,
 		CreatedAt: schema.CreatedAt,
 		UpdatedAt: schema.UpdatedAt,
+
+		AddressStatus: schema.AddressStatus,
 	}
 	if schema.CompanyID.Valid {
 		res.CompanyID = &schema.CompanyID.UUID
@@ -208,11 +457,14 @@ func placeSchemaToModel(schema pgdb.Place) models.Place {
 		Verified:    schema.Verified,
 		Point:       schema.Point,
 		Address:     schema.Address,
+		Timezone:    schema.Timezone,
 		Locale:      schema.Locale,
 		Name:        schema.Name,
 		Description: schema.Description,
 		CreatedAt:   schema.CreatedAt,
 		UpdatedAt:   schema.UpdatedAt,
+
+		AddressStatus: schema.AddressStatus,
 	}
 	if schema.CompanyID.Valid {
 		res.CompanyID = &schema.CompanyID.UUID
@@ -224,18 +476,18 @@ func placeSchemaToModel(schema pgdb.Place) models.Place {
 		res.Phone = &schema.Phone.String
 	}
 
-	var timetable []models.TimeInterval
-	for _, schemaInterval := range schema.Timetable {
-		from := models.NumberMinutesToMoment(schemaInterval.StartMin)
-		to := models.NumberMinutesToMoment(schemaInterval.EndMin)
-		timetable = append(timetable, models.TimeInterval{
-			From: from,
-			To:   to,
-		})
+	if len(schema.Timetable) > 0 {
+		res.Timetable = timetableFromDB(schema.Timetable)
 	}
-
-	res.Timetable = models.Timetable{
-		Table: timetable,
+	if schema.Rank.Valid {
+		res.Rank = &schema.Rank.Float64
+	}
+	if schema.Snippet.Valid {
+		res.Snippet = &schema.Snippet.String
+	}
+	if schema.AddressDetails != nil {
+		addr := addressFromDB(*schema.AddressDetails)
+		res.AddressDetails = &addr
 	}
 
 	return res
@@ -250,8 +502,11 @@ func placeWithDetailsSchemaToModel(schema pgdb.Place) models.Place {
 		Verified:  schema.Verified,
 		Point:     schema.Point,
 		Address:   schema.Address,
+		Timezone:  schema.Timezone,
 		CreatedAt: schema.CreatedAt,
 		UpdatedAt: schema.UpdatedAt,
+
+		AddressStatus: schema.AddressStatus,
 	}
 	if schema.CompanyID.Valid {
 		res.CompanyID = &schema.CompanyID.UUID
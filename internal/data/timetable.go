@@ -2,43 +2,232 @@ package data
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/chains-lab/places-svc/internal/data/pgdb"
 	"github.com/chains-lab/places-svc/internal/domain/models"
 	"github.com/google/uuid"
 )
 
-func (d Database) SetTimetable(ctx context.Context, placeID uuid.UUID, intervals models.Timetable) error {
-	stmt := make([]pgdb.PlaceTimetableRow, 0, len(intervals.Table))
-	for _, interval := range intervals.Table {
+// SetTimetable creates or replaces the named weekly timetable of the place, other timetables are kept.
+// Must be called inside a transaction.
+func (d Database) SetTimetable(ctx context.Context, placeID uuid.UUID, intervals models.Timetable, updatedAt time.Time) error {
+	header := pgdb.PlaceWeeklyTimetableRow{
+		ID:        uuid.New(),
+		PlaceID:   placeID,
+		Name:      intervals.Name,
+		CreatedAt: updatedAt,
+		UpdatedAt: updatedAt,
+	}
+	if intervals.ValidFrom != nil {
+		header.ValidFrom = sql.NullTime{Time: *intervals.ValidFrom, Valid: true}
+	}
+	if intervals.ValidTo != nil {
+		header.ValidTo = sql.NullTime{Time: *intervals.ValidTo, Valid: true}
+	}
+	if intervals.TemplateID != nil {
+		header.TemplateID = uuid.NullUUID{UUID: *intervals.TemplateID, Valid: true}
+	}
+
+	timetableID, err := d.sql.weekly.New().Upsert(ctx, header)
+	if err != nil {
+		return err
+	}
+
+	return d.setTimetableRows(ctx, placeID, timetableID, intervals.Table)
+}
+
+// setTimetableRows replaces the intervals of one weekly timetable of the place.
+func (d Database) setTimetableRows(ctx context.Context, placeID, timetableID uuid.UUID, table []models.TimeInterval) error {
+	if err := d.sql.timetables.New().FilterTimetableID(timetableID).Delete(ctx); err != nil {
+		return err
+	}
+
+	spans := weekSpans(table)
+	stmt := make([]pgdb.PlaceTimetableRow, 0, len(spans))
+	for _, span := range spans {
 		stmt = append(stmt, pgdb.PlaceTimetableRow{
-			ID:       uuid.New(),
-			PlaceID:  placeID,
-			StartMin: interval.From.ToNumberMinutes(),
-			EndMin:   interval.To.ToNumberMinutes(),
+			ID:          uuid.New(),
+			PlaceID:     placeID,
+			TimetableID: timetableID,
+			StartMin:    span.start,
+			EndMin:      span.end,
+			Wraps:       span.wraps,
 		})
 	}
 
 	return d.sql.timetables.New().Upsert(ctx, stmt...)
 }
 
-func (d Database) GetTimetableByPlaceID(ctx context.Context, placeID uuid.UUID) (models.Timetable, error) {
+// GetTimetablesByPlaceID returns every named weekly timetable of the place, ordered by validity.
+func (d Database) GetTimetablesByPlaceID(ctx context.Context, placeID uuid.UUID) ([]models.Timetable, error) {
+	headers, err := d.sql.weekly.New().FilterPlaceID(placeID).OrderByValidity().Select(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(headers) == 0 {
+		return nil, nil
+	}
+
 	rows, err := d.sql.timetables.New().FilterPlaceID(placeID).Select(ctx)
 	if err != nil {
-		return models.Timetable{}, err
+		return nil, err
 	}
 
-	intervals := make([]models.TimeInterval, 0, len(rows))
+	byTimetable := make(map[uuid.UUID][]pgdb.PlaceTimetableRow, len(headers))
 	for _, row := range rows {
-		intervals = append(intervals, models.TimeInterval{
-			From: models.NumberMinutesToMoment(row.StartMin),
-			To:   models.NumberMinutesToMoment(row.EndMin),
-		})
+		byTimetable[row.TimetableID] = append(byTimetable[row.TimetableID], row)
+	}
+
+	res := make([]models.Timetable, 0, len(headers))
+	for _, header := range headers {
+		res = append(res, weeklyTimetableFromDB(header, byTimetable[header.ID]))
+	}
+
+	return res, nil
+}
+
+// GetTimetableForUpdate returns the named weekly timetable of the place and locks it until the end of
+// the transaction, so concurrent edits of single days are applied one after another.
+// A zero Timetable is returned when there is no such timetable. Must be called inside a transaction.
+func (d Database) GetTimetableForUpdate(ctx context.Context, placeID uuid.UUID, name string) (models.Timetable, error) {
+	header, err := d.sql.weekly.New().FilterPlaceID(placeID).FilterName(name).ForUpdate().Get(ctx)
+	switch {
+	case err == sql.ErrNoRows:
+		return models.Timetable{}, nil
+	case err != nil:
+		return models.Timetable{}, err
+	}
+
+	rows, err := d.sql.timetables.New().FilterTimetableID(header.ID).Select(ctx)
+	if err != nil {
+		return models.Timetable{}, err
 	}
 
-	return models.Timetable{Table: intervals}, nil
+	return weeklyTimetableFromDB(header, rows), nil
 }
 
+// DeleteTimetableByPlaceID removes all weekly timetables of the place, intervals go with them.
 func (d Database) DeleteTimetableByPlaceID(ctx context.Context, placeID uuid.UUID) error {
-	return d.sql.timetables.New().FilterPlaceID(placeID).Delete(ctx)
+	return d.sql.weekly.New().FilterPlaceID(placeID).Delete(ctx)
+}
+
+func (d Database) DeleteTimetableByName(ctx context.Context, placeID uuid.UUID, name string) error {
+	return d.sql.weekly.New().FilterPlaceID(placeID).FilterName(name).Delete(ctx)
+}
+
+// DetachTimetableTemplate unlinks the named timetable of the place from its template,
+// the intervals copied from the template are kept as a local timetable.
+func (d Database) DetachTimetableTemplate(ctx context.Context, placeID uuid.UUID, name string, updatedAt time.Time) error {
+	return d.sql.weekly.New().
+		FilterPlaceID(placeID).
+		FilterName(name).
+		UpdateTemplateID(uuid.NullUUID{}).
+		Update(ctx, updatedAt)
+}
+
+func (d Database) SetTimetableException(ctx context.Context, placeID uuid.UUID, exception models.TimetableException) error {
+	var reason sql.NullString
+	if exception.Reason != nil {
+		reason = sql.NullString{String: *exception.Reason, Valid: true}
+	}
+
+	if exception.Closed() {
+		return d.sql.exceptions.New().Insert(ctx, pgdb.PlaceTimetableExceptionRow{
+			ID:      uuid.New(),
+			PlaceID: placeID,
+			Date:    exception.Date,
+			Reason:  reason,
+		})
+	}
+
+	stmt := make([]pgdb.PlaceTimetableExceptionRow, 0, len(exception.Intervals))
+	for _, interval := range exception.Intervals {
+		stmt = append(stmt, pgdb.PlaceTimetableExceptionRow{
+			ID:       uuid.New(),
+			PlaceID:  placeID,
+			Date:     exception.Date,
+			StartMin: sql.NullInt64{Int64: int64(interval.From / time.Minute), Valid: true},
+			EndMin:   sql.NullInt64{Int64: int64(interval.To / time.Minute), Valid: true},
+			Reason:   reason,
+		})
+	}
+
+	return d.sql.exceptions.New().Insert(ctx, stmt...)
+}
+
+func (d Database) GetTimetableExceptions(
+	ctx context.Context,
+	placeID uuid.UUID,
+	from, to *time.Time,
+) ([]models.TimetableException, error) {
+	query := d.sql.exceptions.New().FilterPlaceID(placeID)
+	if from != nil {
+		query = query.FilterDateFrom(*from)
+	}
+	if to != nil {
+		query = query.FilterDateTo(*to)
+	}
+
+	rows, err := query.OrderByDate(true).Select(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return timetableExceptionsFromDB(rows), nil
+}
+
+func (d Database) TimetableExceptionExists(ctx context.Context, placeID uuid.UUID, date time.Time) (bool, error) {
+	count, err := d.sql.exceptions.New().FilterPlaceID(placeID).FilterDate(date).Count(ctx)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (d Database) DeleteTimetableException(ctx context.Context, placeID uuid.UUID, date time.Time) error {
+	return d.sql.exceptions.New().FilterPlaceID(placeID).FilterDate(date).Delete(ctx)
+}
+
+func weeklyTimetableFromDB(header pgdb.PlaceWeeklyTimetableRow, rows []pgdb.PlaceTimetableRow) models.Timetable {
+	tt := timetableFromDB(rows)
+	tt.Name = header.Name
+	if header.ValidFrom.Valid {
+		tt.ValidFrom = &header.ValidFrom.Time
+	}
+	if header.ValidTo.Valid {
+		tt.ValidTo = &header.ValidTo.Time
+	}
+	if header.TemplateID.Valid {
+		tt.TemplateID = &header.TemplateID.UUID
+	}
+
+	return tt
+}
+
+// timetableExceptionsFromDB groups exception rows (expected to be ordered by date) into one exception per date.
+func timetableExceptionsFromDB(rows []pgdb.PlaceTimetableExceptionRow) []models.TimetableException {
+	res := make([]models.TimetableException, 0, len(rows))
+	for _, row := range rows {
+		date := time.Date(row.Date.Year(), row.Date.Month(), row.Date.Day(), 0, 0, 0, 0, time.UTC)
+
+		if len(res) == 0 || !res[len(res)-1].Date.Equal(date) {
+			exception := models.TimetableException{Date: date}
+			if row.Reason.Valid {
+				exception.Reason = &row.Reason.String
+			}
+			res = append(res, exception)
+		}
+
+		if row.StartMin.Valid && row.EndMin.Valid {
+			last := &res[len(res)-1]
+			last.Intervals = append(last.Intervals, models.DayInterval{
+				From: time.Duration(row.StartMin.Int64) * time.Minute,
+				To:   time.Duration(row.EndMin.Int64) * time.Minute,
+			})
+		}
+	}
+
+	return res
 }
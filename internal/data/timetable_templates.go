@@ -0,0 +1,192 @@
+package data
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/chains-lab/places-svc/internal/data/pgdb"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/chains-lab/places-svc/internal/domain/services/ttemplate"
+	"github.com/chains-lab/restkit/pagi"
+	"github.com/google/uuid"
+)
+
+// CreateTimetableTemplate stores the template with its intervals. Must be called inside a transaction.
+func (d Database) CreateTimetableTemplate(ctx context.Context, template models.TimetableTemplate) error {
+	err := d.sql.templates.New().Insert(ctx, pgdb.TimetableTemplateRow{
+		ID:        template.ID,
+		CompanyID: template.CompanyID,
+		Name:      template.Name,
+		CreatedAt: template.CreatedAt,
+		UpdatedAt: template.UpdatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	return d.setTimetableTemplateRows(ctx, template.ID, template.Table)
+}
+
+func (d Database) GetTimetableTemplate(ctx context.Context, templateID uuid.UUID) (models.TimetableTemplate, error) {
+	row, err := d.sql.templates.New().FilterID(templateID).Get(ctx)
+	switch {
+	case err == sql.ErrNoRows:
+		return models.TimetableTemplate{}, nil
+	case err != nil:
+		return models.TimetableTemplate{}, err
+	}
+
+	intervals, err := d.sql.tIntervals.New().FilterTemplateID(templateID).Select(ctx)
+	if err != nil {
+		return models.TimetableTemplate{}, err
+	}
+
+	return timetableTemplateFromDB(row, intervals), nil
+}
+
+func (d Database) TimetableTemplateExistsByName(ctx context.Context, companyID uuid.UUID, name string) (bool, error) {
+	count, err := d.sql.templates.New().FilterCompanyID(companyID).FilterName(name).Count(ctx)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func (d Database) FilterTimetableTemplates(
+	ctx context.Context,
+	companyID uuid.UUID,
+	page, size uint64,
+) (models.TimetableTemplatesCollection, error) {
+	limit, offset := pagi.PagConvert(page, size)
+
+	query := d.sql.templates.New().FilterCompanyID(companyID)
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return models.TimetableTemplatesCollection{}, err
+	}
+
+	rows, err := query.OrderByName(true).Page(limit, offset).Select(ctx)
+	if err != nil {
+		return models.TimetableTemplatesCollection{}, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.ID)
+	}
+
+	byTemplate := make(map[uuid.UUID][]pgdb.TimetableTemplateIntervalRow, len(rows))
+	if len(ids) > 0 {
+		intervals, err := d.sql.tIntervals.New().FilterTemplateID(ids...).Select(ctx)
+		if err != nil {
+			return models.TimetableTemplatesCollection{}, err
+		}
+		for _, interval := range intervals {
+			byTemplate[interval.TemplateID] = append(byTemplate[interval.TemplateID], interval)
+		}
+	}
+
+	collection := make([]models.TimetableTemplate, 0, len(rows))
+	for _, row := range rows {
+		collection = append(collection, timetableTemplateFromDB(row, byTemplate[row.ID]))
+	}
+
+	return models.TimetableTemplatesCollection{
+		Data:  collection,
+		Page:  page,
+		Size:  size,
+		Total: total,
+	}, nil
+}
+
+// UpdateTimetableTemplate changes the template itself, the places linked to it are synced
+// separately by SyncTimetablesWithTemplate. Must be called inside a transaction.
+func (d Database) UpdateTimetableTemplate(
+	ctx context.Context,
+	templateID uuid.UUID,
+	params ttemplate.UpdateParams,
+	updatedAt time.Time,
+) error {
+	query := d.sql.templates.New().FilterID(templateID)
+	if params.Name != nil {
+		query = query.UpdateName(*params.Name)
+	}
+	if err := query.Update(ctx, updatedAt); err != nil {
+		return err
+	}
+
+	if params.Table == nil {
+		return nil
+	}
+
+	return d.setTimetableTemplateRows(ctx, templateID, *params.Table)
+}
+
+// SyncTimetablesWithTemplate rewrites the intervals of every place timetable linked to the template.
+// Must be called inside a transaction.
+func (d Database) SyncTimetablesWithTemplate(
+	ctx context.Context,
+	templateID uuid.UUID,
+	table []models.TimeInterval,
+	updatedAt time.Time,
+) error {
+	linked, err := d.sql.weekly.New().FilterTemplateID(templateID).Select(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, header := range linked {
+		if err = d.setTimetableRows(ctx, header.PlaceID, header.ID, table); err != nil {
+			return err
+		}
+	}
+
+	if len(linked) == 0 {
+		return nil
+	}
+
+	return d.sql.weekly.New().FilterTemplateID(templateID).Update(ctx, updatedAt)
+}
+
+// DeleteTimetableTemplate removes the template, linked place timetables are detached and keep their copy.
+func (d Database) DeleteTimetableTemplate(ctx context.Context, templateID uuid.UUID) error {
+	return d.sql.templates.New().FilterID(templateID).Delete(ctx)
+}
+
+func (d Database) setTimetableTemplateRows(ctx context.Context, templateID uuid.UUID, table []models.TimeInterval) error {
+	if err := d.sql.tIntervals.New().FilterTemplateID(templateID).Delete(ctx); err != nil {
+		return err
+	}
+
+	spans := weekSpans(table)
+	stmt := make([]pgdb.TimetableTemplateIntervalRow, 0, len(spans))
+	for _, span := range spans {
+		stmt = append(stmt, pgdb.TimetableTemplateIntervalRow{
+			ID:         uuid.New(),
+			TemplateID: templateID,
+			StartMin:   span.start,
+			EndMin:     span.end,
+			Wraps:      span.wraps,
+		})
+	}
+
+	return d.sql.tIntervals.New().Insert(ctx, stmt...)
+}
+
+func timetableTemplateFromDB(row pgdb.TimetableTemplateRow, intervals []pgdb.TimetableTemplateIntervalRow) models.TimetableTemplate {
+	spans := make([]weekSpan, 0, len(intervals))
+	for _, interval := range intervals {
+		spans = append(spans, weekSpan{start: interval.StartMin, end: interval.EndMin, wraps: interval.Wraps})
+	}
+
+	return models.TimetableTemplate{
+		ID:        row.ID,
+		CompanyID: row.CompanyID,
+		Name:      row.Name,
+		Table:     intervalsFromSpans(spans),
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+}
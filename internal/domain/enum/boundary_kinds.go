@@ -0,0 +1,28 @@
+package enum
+
+import "fmt"
+
+// виды границ: город и район внутри города
+const BoundaryKindCity = "city"
+const BoundaryKindDistrict = "district"
+
+var boundaryKinds = []string{
+	BoundaryKindCity,
+	BoundaryKindDistrict,
+}
+
+var ErrorInvalidBoundaryKind = fmt.Errorf("invalid boundary kind, must be one of: %v", boundaryKinds)
+
+func CheckBoundaryKind(kind string) error {
+	for _, k := range boundaryKinds {
+		if k == kind {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("'%s': %w", kind, ErrorInvalidBoundaryKind)
+}
+
+func GetAllBoundaryKinds() []string {
+	return boundaryKinds
+}
@@ -0,0 +1,30 @@
+package enum
+
+import "fmt"
+
+// статусы поиска адреса места фоновой задачей
+const PlaceAddressStatusPending = "pending"
+const PlaceAddressStatusResolved = "resolved"
+const PlaceAddressStatusFailed = "failed"
+
+var placeAddressStatuses = []string{
+	PlaceAddressStatusPending,
+	PlaceAddressStatusResolved,
+	PlaceAddressStatusFailed,
+}
+
+var ErrorInvalidPlaceAddressStatus = fmt.Errorf("invalid place address status, must be one of: %v", placeAddressStatuses)
+
+func CheckPlaceAddressStatus(status string) error {
+	for _, s := range placeAddressStatuses {
+		if s == status {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("'%s': %w", status, ErrorInvalidPlaceAddressStatus)
+}
+
+func GetAllPlaceAddressStatuses() []string {
+	return placeAddressStatuses
+}
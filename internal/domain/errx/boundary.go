@@ -0,0 +1,6 @@
+package errx
+
+import "github.com/chains-lab/ape"
+
+// ErrorInvalidBoundary indicates that a city or district boundary is malformed or refers to an unknown city
+var ErrorInvalidBoundary = ape.DeclareError("INVALID_BOUNDARY")
@@ -13,3 +13,37 @@ var ErrorPlaceForDeleteMustBeInactive = ape.DeclareError("PLACE_FOR_DELETE_MUST_
 
 // ErrorInvalidLocale indicates that the provided locale is invalid or not supported
 var ErrorCannotSetStatusBlocked = ape.DeclareError("CANNOT_SET_STATUS_BLOCKED")
+
+// ErrorInvalidTimezone indicates that the provided time zone is not a known IANA time zone
+var ErrorInvalidTimezone = ape.DeclareError("INVALID_TIMEZONE")
+
+// ErrorInvalidSearchArea indicates that the bounding box or the polygon to search places in is malformed
+var ErrorInvalidSearchArea = ape.DeclareError("INVALID_SEARCH_AREA")
+
+// ErrorInvalidTile indicates that the requested map tile coordinates are out of the zoom level range
+var ErrorInvalidTile = ape.DeclareError("INVALID_TILE")
+
+// ErrorInvalidPlacesCursor indicates that the pagination cursor is malformed or does not match the requested sort
+var ErrorInvalidPlacesCursor = ape.DeclareError("INVALID_PLACES_CURSOR")
+
+// ErrorInvalidPlaceFacet indicates that the requested facet of places is not supported
+var ErrorInvalidPlaceFacet = ape.DeclareError("INVALID_PLACE_FACET")
+
+// ErrorInvalidPlacesImport indicates that a bulk import of places is malformed or too large
+var ErrorInvalidPlacesImport = ape.DeclareError("INVALID_PLACES_IMPORT")
+
+// ErrorPlaceOfAnotherCompany indicates that a place to update belongs to another company than the one acting on it
+var ErrorPlaceOfAnotherCompany = ape.DeclareError("PLACE_OF_ANOTHER_COMPANY")
+
+// ErrorAddressNotFound indicates that geocoding found no point for the address of a place
+var ErrorAddressNotFound = ape.DeclareError("ADDRESS_NOT_FOUND")
+
+// ErrorAddressAmbiguous indicates that geocoding found several points for the address of a place,
+// the cause is a *models.AmbiguousAddress with the candidates to pick from
+var ErrorAddressAmbiguous = ape.DeclareError("ADDRESS_AMBIGUOUS")
+
+// ErrorPlaceOutsideCity indicates that the point of a place lies outside of the boundary of its city
+var ErrorPlaceOutsideCity = ape.DeclareError("PLACE_OUTSIDE_CITY")
+
+// ErrorPlaceCityNotFound indicates that a place was created without a city and no known city boundary covers its point
+var ErrorPlaceCityNotFound = ape.DeclareError("PLACE_CITY_NOT_FOUND")
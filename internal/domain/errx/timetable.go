@@ -0,0 +1,31 @@
+package errx
+
+import "github.com/chains-lab/ape"
+
+// ErrorInvalidTimetable is used when weekly intervals are empty, out of the week or overlap each other
+// Its 400 - Bad Request
+var ErrorInvalidTimetable = ape.DeclareError("INVALID_TIMETABLE")
+
+// ErrorTimetableNotFound is used when the place has no weekly timetable with the requested name
+// Its 404 - Not Found
+var ErrorTimetableNotFound = ape.DeclareError("TIMETABLE_NOT_FOUND")
+
+// ErrorTimetableExceptionNotFound is used when we try to delete exception for date that has no exception
+// Its 404 - Not Found
+var ErrorTimetableExceptionNotFound = ape.DeclareError("TIMETABLE_EXCEPTION_NOT_FOUND")
+
+// ErrorInvalidTimetableException is used when exception intervals are out of the day or overlap each other
+// Its 400 - Bad Request
+var ErrorInvalidTimetableException = ape.DeclareError("INVALID_TIMETABLE_EXCEPTION")
+
+// ErrorTimetableTemplateNotFound is used when we try to get/update/delete/link timetable template by id, but it does not exist
+// Its 404 - Not Found
+var ErrorTimetableTemplateNotFound = ape.DeclareError("TIMETABLE_TEMPLATE_NOT_FOUND")
+
+// ErrorTimetableTemplateNameAlreadyTaken is used when we try to create/update template with name that the company already uses
+// Its 409 - Conflict
+var ErrorTimetableTemplateNameAlreadyTaken = ape.DeclareError("TIMETABLE_TEMPLATE_NAME_ALREADY_TAKEN")
+
+// ErrorTimetableTemplateOfOtherCompany is used when we try to link place to template of another company
+// Its 403 - Forbidden
+var ErrorTimetableTemplateOfOtherCompany = ape.DeclareError("TIMETABLE_TEMPLATE_OF_OTHER_COMPANY")
@@ -0,0 +1,140 @@
+package geo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/paulmach/orb"
+	orbgeo "github.com/paulmach/orb/geo"
+)
+
+// FileReverseRadiusM is how far from a point a fixture place is still its address.
+const FileReverseRadiusM = 250
+
+// FilePlace is a fixture place, Addresses are keyed by locale.
+type FilePlace struct {
+	ID        string                         `json:"id"`
+	Lon       float64                        `json:"lon"`
+	Lat       float64                        `json:"lat"`
+	Addresses map[string]models.PlaceAddress `json:"addresses"`
+}
+
+// FileProvider geocodes with a fixed list of places, for tests and offline development.
+// A point far from every place has an empty address, an address matches the places whose
+// formatted address in some locale contains every word of it.
+type FileProvider struct {
+	places []FilePlace
+}
+
+var _ Provider = (*FileProvider)(nil)
+
+func NewFileProvider(places []FilePlace) *FileProvider {
+	return &FileProvider{places: places}
+}
+
+// LoadFileProvider reads the places of a FileProvider from a JSON array of FilePlace.
+func LoadFileProvider(path string) (*FileProvider, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read geocode fixture: %w", err)
+	}
+
+	var places []FilePlace
+	if err = json.Unmarshal(raw, &places); err != nil {
+		return nil, fmt.Errorf("decode geocode fixture %s: %w", path, err)
+	}
+
+	return NewFileProvider(places), nil
+}
+
+func (f *FileProvider) Guess(_ context.Context, pt orb.Point, locale string) (models.PlaceAddress, error) {
+	var (
+		nearest *FilePlace
+		best    float64
+	)
+	for i := range f.places {
+		d := orbgeo.Distance(pt, f.places[i].point())
+		if d <= FileReverseRadiusM && (nearest == nil || d < best) {
+			nearest, best = &f.places[i], d
+		}
+	}
+
+	if nearest == nil {
+		return models.PlaceAddress{Locale: locale}, nil
+	}
+	return nearest.address(locale), nil
+}
+
+func (f *FileProvider) Locate(_ context.Context, query, locale string) ([]models.GeoCandidate, error) {
+	words := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return nil, nil
+	}
+
+	var res []models.GeoCandidate
+	for _, p := range f.places {
+		if !p.matches(words) {
+			continue
+		}
+		res = append(res, models.GeoCandidate{
+			ID:      p.ID,
+			Point:   p.point(),
+			Address: p.address(locale),
+		})
+	}
+
+	sort.SliceStable(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+	if len(res) > LocateLimit {
+		res = res[:LocateLimit]
+	}
+	return res, nil
+}
+
+func (p FilePlace) point() orb.Point {
+	return orb.Point{p.Lon, p.Lat}
+}
+
+// address is the address in the locale, or in English, or in any locale the place has.
+func (p FilePlace) address(locale string) models.PlaceAddress {
+	addr, ok := p.Addresses[locale]
+	if !ok {
+		addr, ok = p.Addresses["en"]
+	}
+	if !ok && len(p.Addresses) > 0 {
+		locales := make([]string, 0, len(p.Addresses))
+		for l := range p.Addresses {
+			locales = append(locales, l)
+		}
+		sort.Strings(locales)
+		addr = p.Addresses[locales[0]]
+	}
+
+	addr.Locale = locale
+	return addr
+}
+
+func (p FilePlace) matches(words []string) bool {
+	for _, addr := range p.Addresses {
+		formatted := strings.ToLower(addr.Formatted)
+
+		all := true
+		for _, w := range words {
+			if !strings.Contains(formatted, w) {
+				all = false
+				break
+			}
+		}
+		if all {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+package geo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// httpProvider is the transport shared by the HTTP providers: base URL, timeout, user agent and rate limit.
+type httpProvider struct {
+	client    *http.Client
+	baseURL   string
+	userAgent string
+	limiter   *Limiter
+	// header sets the API key on a request, nil when the provider has none
+	header func(req *http.Request)
+}
+
+func newHTTPProvider(cfg ProviderConfig, defaultURL string, defaultInterval time.Duration) httpProvider {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = defaultURL
+	}
+	userAgent := cfg.UserAgent
+	if userAgent == "" {
+		userAgent = defaultUserAgent
+	}
+	interval := cfg.Interval
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+
+	return httpProvider{
+		client:    &http.Client{Timeout: timeout},
+		baseURL:   strings.TrimRight(baseURL, "/"),
+		userAgent: userAgent,
+		limiter:   NewLimiter(interval),
+	}
+}
+
+func (p httpProvider) get(ctx context.Context, path string, q url.Values, out any) error {
+	if err := p.limiter.Wait(ctx); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.baseURL+path+"?"+q.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("User-Agent", p.userAgent)
+	if p.header != nil {
+		p.header(req)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("geocode failed: %s", resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// joinNonEmpty joins the non-empty parts with sep.
+func joinNonEmpty(sep string, parts ...string) string {
+	res := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p != "" {
+			res = append(res, p)
+		}
+	}
+	return strings.Join(res, sep)
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
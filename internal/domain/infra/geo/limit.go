@@ -0,0 +1,48 @@
+package geo
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// NominatimInterval is the request rate of the public Nominatim, at most one request per second.
+const NominatimInterval = time.Second
+
+// Limiter spaces the requests to a provider at least an interval apart, across all goroutines.
+type Limiter struct {
+	mu    sync.Mutex
+	every time.Duration
+	next  time.Time
+}
+
+func NewLimiter(every time.Duration) *Limiter {
+	return &Limiter{every: every}
+}
+
+// Wait blocks until the next request may be sent or ctx is done.
+func (l *Limiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	at := l.next
+	if at.Before(now) {
+		at = now
+	}
+	l.next = at.Add(l.every)
+	l.mu.Unlock()
+
+	delay := time.Until(at)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,132 @@
+package geo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/paulmach/orb"
+)
+
+type peliasResp struct {
+	Features []peliasFeature `json:"features"`
+}
+
+type peliasFeature struct {
+	Geometry struct {
+		Coordinates []float64 `json:"coordinates"`
+	} `json:"geometry"`
+	Properties struct {
+		GID           string `json:"gid"`
+		Label         string `json:"label"`
+		Country       string `json:"country"`
+		CountryCode   string `json:"country_code"`
+		Region        string `json:"region"`
+		Locality      string `json:"locality"`
+		LocalAdmin    string `json:"localadmin"`
+		Borough       string `json:"borough"`
+		Neighbourhood string `json:"neighbourhood"`
+		Street        string `json:"street"`
+		HouseNumber   string `json:"housenumber"`
+		PostalCode    string `json:"postalcode"`
+	} `json:"properties"`
+}
+
+// Pelias geocodes with a self-hosted or hosted Pelias, the API key is sent as the api_key param.
+type Pelias struct {
+	httpProvider
+	cfg ProviderConfig
+}
+
+var _ Provider = (*Pelias)(nil)
+
+func NewPelias(cfg ProviderConfig) (*Pelias, error) {
+	if cfg.BaseURL == "" {
+		return nil, errors.New("pelias needs the base url of the instance")
+	}
+
+	return &Pelias{
+		httpProvider: newHTTPProvider(cfg, "", 0),
+		cfg:          cfg,
+	}, nil
+}
+
+func (p *Pelias) Guess(ctx context.Context, pt orb.Point, locale string) (models.PlaceAddress, error) {
+	q := p.query(locale)
+	q.Set("point.lat", fmt.Sprintf("%f", pt[1]))
+	q.Set("point.lon", fmt.Sprintf("%f", pt[0]))
+	q.Set("size", "1")
+
+	var raw peliasResp
+	if err := p.get(ctx, "/v1/reverse", q, &raw); err != nil {
+		return models.PlaceAddress{}, err
+	}
+	if len(raw.Features) == 0 {
+		return models.PlaceAddress{Locale: locale}, nil
+	}
+
+	return raw.Features[0].address(locale), nil
+}
+
+func (p *Pelias) Locate(ctx context.Context, query, locale string) ([]models.GeoCandidate, error) {
+	q := p.query(locale)
+	q.Set("text", query)
+	q.Set("size", strconv.Itoa(LocateLimit))
+
+	var raw peliasResp
+	if err := p.get(ctx, "/v1/search", q, &raw); err != nil {
+		return nil, err
+	}
+
+	res := make([]models.GeoCandidate, 0, len(raw.Features))
+	for _, f := range raw.Features {
+		if len(f.Geometry.Coordinates) < 2 {
+			return nil, fmt.Errorf("invalid coordinates %v in geocode result", f.Geometry.Coordinates)
+		}
+
+		res = append(res, models.GeoCandidate{
+			ID:      f.Properties.GID,
+			Point:   orb.Point{f.Geometry.Coordinates[0], f.Geometry.Coordinates[1]},
+			Address: f.address(locale),
+		})
+	}
+
+	return res, nil
+}
+
+func (p *Pelias) query(locale string) url.Values {
+	q := url.Values{}
+	if lang := p.cfg.language(locale); lang != "" {
+		q.Set("lang", lang)
+	}
+	if p.cfg.APIKey != "" {
+		q.Set("api_key", p.cfg.APIKey)
+	}
+	return q
+}
+
+func (f peliasFeature) address(locale string) models.PlaceAddress {
+	pr := f.Properties
+
+	// старые версии Pelias отдают только трёхбуквенный country_a, такой код не сохраняем
+	countryCode := pr.CountryCode
+	if len(countryCode) != 2 {
+		countryCode = ""
+	}
+
+	return models.PlaceAddress{
+		Locale:      locale,
+		Formatted:   pr.Label,
+		Country:     pr.Country,
+		CountryCode: countryCode,
+		State:       pr.Region,
+		City:        firstNonEmpty(pr.Locality, pr.LocalAdmin),
+		Suburb:      firstNonEmpty(pr.Neighbourhood, pr.Borough),
+		Street:      pr.Street,
+		HouseNumber: pr.HouseNumber,
+		Postcode:    pr.PostalCode,
+	}
+}
@@ -0,0 +1,120 @@
+package geo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+const peliasBody = `{"type": "FeatureCollection", "features": [
+	{"type": "Feature",
+	 "geometry": {"type": "Point", "coordinates": [30.5234, 50.4501]},
+	 "properties": {"gid": "openstreetmap:address:node/42", "label": "22 Khreshchatyk, Kyiv, Ukraine",
+	  "country": "Ukraine", "country_code": "UA", "region": "Kyiv", "localadmin": "Kyiv", "borough": "Shevchenkivskyi",
+	  "street": "Khreshchatyk", "housenumber": "22", "postalcode": "01001"}},
+	{"type": "Feature",
+	 "geometry": {"type": "Point", "coordinates": [24.0316, 49.8419]},
+	 "properties": {"gid": "whosonfirst:locality:101751119", "label": "Lviv, Ukraine", "country": "Ukraine",
+	  "country_code": "UKR", "locality": "Lviv", "localadmin": "Lviv Hromada"}}
+]}`
+
+func TestPelias(t *testing.T) {
+	var (
+		path  string
+		query url.Values
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, query = r.URL.Path, r.URL.Query()
+		w.Write([]byte(peliasBody))
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+
+	t.Run("guess", func(t *testing.T) {
+		p, err := NewPelias(ProviderConfig{BaseURL: srv.URL, APIKey: "secret"})
+		if err != nil {
+			t.Fatalf("NewPelias: %v", err)
+		}
+
+		addr, err := p.Guess(ctx, orb.Point{30.52, 50.45}, "uk")
+		if err != nil {
+			t.Fatalf("Guess: %v", err)
+		}
+
+		if path != "/v1/reverse" || query.Get("point.lat") != "50.450000" || query.Get("point.lon") != "30.520000" {
+			t.Fatalf("unexpected request %s?%s", path, query.Encode())
+		}
+		if query.Get("api_key") != "secret" {
+			t.Fatalf("expected the api key as the api_key param, got %q", query.Get("api_key"))
+		}
+		if query.Get("lang") != "uk" {
+			t.Fatalf("expected the locale to pass through, got lang=%q", query.Get("lang"))
+		}
+
+		if addr.Locale != "uk" || addr.Formatted != "22 Khreshchatyk, Kyiv, Ukraine" || addr.CountryCode != "UA" ||
+			addr.City != "Kyiv" || addr.Suburb != "Shevchenkivskyi" || addr.Street != "Khreshchatyk" ||
+			addr.HouseNumber != "22" || addr.Postcode != "01001" {
+			t.Fatalf("unexpected address %+v", addr)
+		}
+	})
+
+	t.Run("locate", func(t *testing.T) {
+		p, err := NewPelias(ProviderConfig{BaseURL: srv.URL, Languages: []string{"en", "uk"}})
+		if err != nil {
+			t.Fatalf("NewPelias: %v", err)
+		}
+
+		res, err := p.Locate(ctx, "Lviv", "ru")
+		if err != nil {
+			t.Fatalf("Locate: %v", err)
+		}
+
+		if path != "/v1/search" || query.Get("text") != "Lviv" {
+			t.Fatalf("unexpected request %s?%s", path, query.Encode())
+		}
+		if query.Has("api_key") {
+			t.Fatalf("expected no api_key without an api key, got %q", query.Get("api_key"))
+		}
+		if query.Get("lang") != "en" {
+			t.Fatalf("expected the first language for an unsupported locale, got lang=%q", query.Get("lang"))
+		}
+
+		if len(res) != 2 || res[1].ID != "whosonfirst:locality:101751119" || res[1].Point != (orb.Point{24.0316, 49.8419}) {
+			t.Fatalf("unexpected candidates %+v", res)
+		}
+		// трёхбуквенный код страны не сохраняется, город берётся из locality раньше localadmin
+		if res[1].Address.CountryCode != "" || res[1].Address.City != "Lviv" {
+			t.Fatalf("unexpected address %+v", res[1].Address)
+		}
+	})
+
+	t.Run("error status", func(t *testing.T) {
+		failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer failing.Close()
+
+		p, err := NewPelias(ProviderConfig{BaseURL: failing.URL, APIKey: "wrong"})
+		if err != nil {
+			t.Fatalf("NewPelias: %v", err)
+		}
+
+		if _, err := p.Guess(ctx, orb.Point{30.52, 50.45}, "en"); err == nil {
+			t.Fatalf("expected an error on a non-200 response to guess")
+		}
+		if _, err := p.Locate(ctx, "Lviv", "en"); err == nil {
+			t.Fatalf("expected an error on a non-200 response to locate")
+		}
+	})
+
+	t.Run("base url is required", func(t *testing.T) {
+		if _, err := NewPelias(ProviderConfig{}); err == nil {
+			t.Fatalf("expected an error without a base url")
+		}
+	})
+}
@@ -0,0 +1,133 @@
+package geo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/paulmach/orb"
+)
+
+type photonResp struct {
+	Features []photonFeature `json:"features"`
+}
+
+type photonFeature struct {
+	Geometry struct {
+		Coordinates []float64 `json:"coordinates"`
+	} `json:"geometry"`
+	Properties struct {
+		OsmType     string `json:"osm_type"`
+		OsmID       int64  `json:"osm_id"`
+		Name        string `json:"name"`
+		Country     string `json:"country"`
+		CountryCode string `json:"countrycode"`
+		State       string `json:"state"`
+		City        string `json:"city"`
+		District    string `json:"district"`
+		Locality    string `json:"locality"`
+		Street      string `json:"street"`
+		HouseNumber string `json:"housenumber"`
+		Postcode    string `json:"postcode"`
+	} `json:"properties"`
+}
+
+// Photon geocodes with a self-hosted Photon. The API key, if any, is sent as a bearer token
+// for the proxy in front of it.
+type Photon struct {
+	httpProvider
+	cfg ProviderConfig
+}
+
+var _ Provider = (*Photon)(nil)
+
+func NewPhoton(cfg ProviderConfig) (*Photon, error) {
+	if cfg.BaseURL == "" {
+		return nil, errors.New("photon needs the base url of the instance")
+	}
+
+	p := &Photon{
+		httpProvider: newHTTPProvider(cfg, "", 0),
+		cfg:          cfg,
+	}
+	if cfg.APIKey != "" {
+		p.header = func(req *http.Request) {
+			req.Header.Set("Authorization", "Bearer "+cfg.APIKey)
+		}
+	}
+
+	return p, nil
+}
+
+func (p *Photon) Guess(ctx context.Context, pt orb.Point, locale string) (models.PlaceAddress, error) {
+	q := p.query(locale)
+	q.Set("lat", fmt.Sprintf("%f", pt[1]))
+	q.Set("lon", fmt.Sprintf("%f", pt[0]))
+	q.Set("limit", "1")
+
+	var raw photonResp
+	if err := p.get(ctx, "/reverse", q, &raw); err != nil {
+		return models.PlaceAddress{}, err
+	}
+	if len(raw.Features) == 0 {
+		return models.PlaceAddress{Locale: locale}, nil
+	}
+
+	return raw.Features[0].address(locale), nil
+}
+
+func (p *Photon) Locate(ctx context.Context, query, locale string) ([]models.GeoCandidate, error) {
+	q := p.query(locale)
+	q.Set("q", query)
+	q.Set("limit", strconv.Itoa(LocateLimit))
+
+	var raw photonResp
+	if err := p.get(ctx, "/api", q, &raw); err != nil {
+		return nil, err
+	}
+
+	res := make([]models.GeoCandidate, 0, len(raw.Features))
+	for _, f := range raw.Features {
+		if len(f.Geometry.Coordinates) < 2 {
+			return nil, fmt.Errorf("invalid coordinates %v in geocode result", f.Geometry.Coordinates)
+		}
+
+		res = append(res, models.GeoCandidate{
+			ID:      fmt.Sprintf("%s/%d", f.Properties.OsmType, f.Properties.OsmID),
+			Point:   orb.Point{f.Geometry.Coordinates[0], f.Geometry.Coordinates[1]},
+			Address: f.address(locale),
+		})
+	}
+
+	return res, nil
+}
+
+func (p *Photon) query(locale string) url.Values {
+	q := url.Values{}
+	if lang := p.cfg.language(locale); lang != "" {
+		q.Set("lang", lang)
+	}
+	return q
+}
+
+func (f photonFeature) address(locale string) models.PlaceAddress {
+	pr := f.Properties
+	street := joinNonEmpty(" ", pr.Street, pr.HouseNumber)
+
+	return models.PlaceAddress{
+		Locale:      locale,
+		Formatted:   joinNonEmpty(", ", firstNonEmpty(street, pr.Name), pr.City, pr.Postcode, pr.Country),
+		Country:     pr.Country,
+		CountryCode: pr.CountryCode,
+		State:       pr.State,
+		City:        pr.City,
+		Suburb:      firstNonEmpty(pr.District, pr.Locality),
+		Street:      pr.Street,
+		HouseNumber: pr.HouseNumber,
+		Postcode:    pr.Postcode,
+	}
+}
@@ -0,0 +1,122 @@
+package geo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+const photonBody = `{"type": "FeatureCollection", "features": [
+	{"type": "Feature",
+	 "geometry": {"type": "Point", "coordinates": [30.5234, 50.4501]},
+	 "properties": {"osm_type": "N", "osm_id": 42, "name": "Maidan", "country": "Ukraine", "countrycode": "UA",
+	  "state": "Kyiv", "city": "Kyiv", "district": "Shevchenkivskyi", "street": "Khreshchatyk", "housenumber": "22",
+	  "postcode": "01001"}}
+]}`
+
+func TestPhoton(t *testing.T) {
+	var (
+		path  string
+		query url.Values
+		auth  string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, query, auth = r.URL.Path, r.URL.Query(), r.Header.Get("Authorization")
+		w.Write([]byte(photonBody))
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+
+	t.Run("guess", func(t *testing.T) {
+		p, err := NewPhoton(ProviderConfig{BaseURL: srv.URL + "/", APIKey: "secret"})
+		if err != nil {
+			t.Fatalf("NewPhoton: %v", err)
+		}
+
+		addr, err := p.Guess(ctx, orb.Point{30.52, 50.45}, "uk")
+		if err != nil {
+			t.Fatalf("Guess: %v", err)
+		}
+
+		if path != "/reverse" || query.Get("lat") != "50.450000" || query.Get("lon") != "30.520000" {
+			t.Fatalf("unexpected request %s?%s", path, query.Encode())
+		}
+		if auth != "Bearer secret" {
+			t.Fatalf("expected the api key as a bearer token, got %q", auth)
+		}
+		if query.Get("lang") != "uk" {
+			t.Fatalf("expected the locale to pass through, got lang=%q", query.Get("lang"))
+		}
+
+		if addr.Locale != "uk" || addr.CountryCode != "UA" || addr.City != "Kyiv" || addr.Suburb != "Shevchenkivskyi" ||
+			addr.Street != "Khreshchatyk" || addr.HouseNumber != "22" || addr.Postcode != "01001" {
+			t.Fatalf("unexpected address %+v", addr)
+		}
+		if addr.Formatted != "Khreshchatyk 22, Kyiv, 01001, Ukraine" {
+			t.Fatalf("unexpected formatted address %q", addr.Formatted)
+		}
+	})
+
+	t.Run("locate", func(t *testing.T) {
+		p, err := NewPhoton(ProviderConfig{BaseURL: srv.URL, Languages: []string{"en", "de"}})
+		if err != nil {
+			t.Fatalf("NewPhoton: %v", err)
+		}
+
+		res, err := p.Locate(ctx, "Khreshchatyk 22", "uk")
+		if err != nil {
+			t.Fatalf("Locate: %v", err)
+		}
+
+		if path != "/api" || query.Get("q") != "Khreshchatyk 22" {
+			t.Fatalf("unexpected request %s?%s", path, query.Encode())
+		}
+		if auth != "" {
+			t.Fatalf("expected no authorization without an api key, got %q", auth)
+		}
+		if query.Get("lang") != "en" {
+			t.Fatalf("expected the first language for an unsupported locale, got lang=%q", query.Get("lang"))
+		}
+
+		if len(res) != 1 || res[0].ID != "N/42" || res[0].Point != (orb.Point{30.5234, 50.4501}) {
+			t.Fatalf("unexpected candidates %+v", res)
+		}
+
+		if _, err := p.Locate(ctx, "Khreshchatyk", "de"); err != nil {
+			t.Fatalf("Locate: %v", err)
+		}
+		if query.Get("lang") != "de" {
+			t.Fatalf("expected a supported locale to be kept, got lang=%q", query.Get("lang"))
+		}
+	})
+
+	t.Run("error status", func(t *testing.T) {
+		failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer failing.Close()
+
+		p, err := NewPhoton(ProviderConfig{BaseURL: failing.URL})
+		if err != nil {
+			t.Fatalf("NewPhoton: %v", err)
+		}
+
+		if _, err := p.Guess(ctx, orb.Point{30.52, 50.45}, "en"); err == nil {
+			t.Fatalf("expected an error on a non-200 response to guess")
+		}
+		if _, err := p.Locate(ctx, "Khreshchatyk", "en"); err == nil {
+			t.Fatalf("expected an error on a non-200 response to locate")
+		}
+	})
+
+	t.Run("base url is required", func(t *testing.T) {
+		if _, err := NewPhoton(ProviderConfig{}); err == nil {
+			t.Fatalf("expected an error without a base url")
+		}
+	})
+}
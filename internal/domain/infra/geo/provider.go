@@ -0,0 +1,192 @@
+package geo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/paulmach/orb"
+)
+
+// Provider is a geocoder: Guess finds the address of a point, Locate finds the points matching an address.
+// Names are in the locale where the provider has them.
+type Provider interface {
+	Guess(ctx context.Context, pt orb.Point, locale string) (models.PlaceAddress, error)
+	Locate(ctx context.Context, query, locale string) ([]models.GeoCandidate, error)
+}
+
+// LocateLimit is the most candidates a provider returns for an address.
+const LocateLimit = 5
+
+// Kinds of providers known to NewRegistry.
+const (
+	ProviderNominatim = "nominatim"
+	ProviderPhoton    = "photon"
+	ProviderPelias    = "pelias"
+	ProviderFile      = "file"
+	ProviderNone      = "none"
+)
+
+const (
+	defaultTimeout   = 5 * time.Second
+	defaultUserAgent = "chains-lab-places-svc"
+)
+
+// ProviderConfig is the setup of one provider, the zero value of a field is the default of the provider.
+type ProviderConfig struct {
+	BaseURL   string
+	APIKey    string
+	UserAgent string
+	Timeout   time.Duration
+	// Interval is the least time between two requests, Nominatim defaults to NominatimInterval.
+	Interval time.Duration
+	// Languages the provider has names in, in order of preference. A locale outside of them
+	// is asked in the first one, an empty list passes every locale through.
+	Languages []string
+	// File is the fixture of the file provider, see LoadFileProvider.
+	File string
+}
+
+// language is the language to ask the provider in for the locale.
+func (c ProviderConfig) language(locale string) string {
+	if len(c.Languages) == 0 {
+		return locale
+	}
+	for _, l := range c.Languages {
+		if l == locale {
+			return locale
+		}
+	}
+	return c.Languages[0]
+}
+
+// Factory makes a provider of one kind.
+type Factory func(cfg ProviderConfig) (Provider, error)
+
+// Registry makes providers by kind.
+type Registry struct {
+	factories map[string]Factory
+}
+
+// NewRegistry knows the Nominatim, Photon, Pelias, file and none providers.
+func NewRegistry() *Registry {
+	r := &Registry{factories: map[string]Factory{}}
+
+	r.Register(ProviderNominatim, func(cfg ProviderConfig) (Provider, error) { return NewNominatim(cfg), nil })
+	r.Register(ProviderPhoton, func(cfg ProviderConfig) (Provider, error) { return NewPhoton(cfg) })
+	r.Register(ProviderPelias, func(cfg ProviderConfig) (Provider, error) { return NewPelias(cfg) })
+	r.Register(ProviderFile, func(cfg ProviderConfig) (Provider, error) {
+		if cfg.File == "" {
+			return nil, errors.New("file provider needs a fixture file")
+		}
+		return LoadFileProvider(cfg.File)
+	})
+	r.Register(ProviderNone, func(ProviderConfig) (Provider, error) { return NoneProvider{}, nil })
+
+	return r
+}
+
+// Register adds or replaces the factory of a kind.
+func (r *Registry) Register(kind string, f Factory) {
+	r.factories[kind] = f
+}
+
+// Kinds are the known kinds of providers, sorted.
+func (r *Registry) Kinds() []string {
+	res := make([]string, 0, len(r.factories))
+	for kind := range r.factories {
+		res = append(res, kind)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// New makes the provider of the kind.
+func (r *Registry) New(kind string, cfg ProviderConfig) (Provider, error) {
+	f, ok := r.factories[kind]
+	if !ok {
+		return nil, fmt.Errorf("unknown geocode provider %q, expected one of %v", kind, r.Kinds())
+	}
+
+	p, err := f(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("geocode provider %s: %w", kind, err)
+	}
+	return p, nil
+}
+
+// Build makes the primary provider, backed by the fallback one unless it is empty, none or the primary itself.
+// Configs are keyed by kind, a kind without one gets the defaults.
+func (r *Registry) Build(primary, fallback string, configs map[string]ProviderConfig) (Provider, error) {
+	p, err := r.New(primary, configs[primary])
+	if err != nil {
+		return nil, err
+	}
+	if fallback == "" || fallback == ProviderNone || fallback == primary {
+		return p, nil
+	}
+
+	f, err := r.New(fallback, configs[fallback])
+	if err != nil {
+		return nil, err
+	}
+	return NewFallback(p, f), nil
+}
+
+// Fallback geocodes with the primary provider, and with the secondary one when the primary fails.
+type Fallback struct {
+	primary   Provider
+	secondary Provider
+}
+
+var _ Provider = (*Fallback)(nil)
+
+func NewFallback(primary, secondary Provider) *Fallback {
+	return &Fallback{primary: primary, secondary: secondary}
+}
+
+func (f *Fallback) Guess(ctx context.Context, pt orb.Point, locale string) (models.PlaceAddress, error) {
+	addr, err := f.primary.Guess(ctx, pt, locale)
+	if err == nil || ctx.Err() != nil {
+		return addr, err
+	}
+
+	addr, fallbackErr := f.secondary.Guess(ctx, pt, locale)
+	if fallbackErr != nil {
+		return models.PlaceAddress{}, errors.Join(err, fallbackErr)
+	}
+	return addr, nil
+}
+
+func (f *Fallback) Locate(ctx context.Context, query, locale string) ([]models.GeoCandidate, error) {
+	res, err := f.primary.Locate(ctx, query, locale)
+	if err == nil || ctx.Err() != nil {
+		return res, err
+	}
+
+	res, fallbackErr := f.secondary.Locate(ctx, query, locale)
+	if fallbackErr != nil {
+		return nil, errors.Join(err, fallbackErr)
+	}
+	return res, nil
+}
+
+// ErrGeocoderDisabled is returned by NoneProvider.Guess, so an address is never taken as looked up
+// while geocoding is turned off and a fallback provider is asked instead.
+var ErrGeocoderDisabled = errors.New("geocoding is disabled")
+
+// NoneProvider is geocoding turned off: no point has an address and no address has a point.
+type NoneProvider struct{}
+
+var _ Provider = NoneProvider{}
+
+func (NoneProvider) Guess(context.Context, orb.Point, string) (models.PlaceAddress, error) {
+	return models.PlaceAddress{}, ErrGeocoderDisabled
+}
+
+func (NoneProvider) Locate(context.Context, string, string) ([]models.GeoCandidate, error) {
+	return nil, nil
+}
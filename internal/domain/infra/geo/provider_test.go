@@ -0,0 +1,38 @@
+package geo
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/paulmach/orb"
+)
+
+func TestNoneProvider(t *testing.T) {
+	ctx := context.Background()
+	pt := orb.Point{30.52, 50.45}
+
+	if _, err := (NoneProvider{}).Guess(ctx, pt, "en"); !errors.Is(err, ErrGeocoderDisabled) {
+		t.Fatalf("expected ErrGeocoderDisabled, got %v", err)
+	}
+
+	t.Run("fallback is asked", func(t *testing.T) {
+		secondary := NewFileProvider([]FilePlace{{
+			ID:  "maidan",
+			Lon: 30.52,
+			Lat: 50.45,
+			Addresses: map[string]models.PlaceAddress{
+				"en": {Formatted: "Maidan Nezalezhnosti, Kyiv"},
+			},
+		}})
+
+		addr, err := NewFallback(NoneProvider{}, secondary).Guess(ctx, pt, "en")
+		if err != nil {
+			t.Fatalf("Guess: %v", err)
+		}
+		if addr.Formatted != "Maidan Nezalezhnosti, Kyiv" {
+			t.Fatalf("expected the address of the fallback, got %+v", addr)
+		}
+	})
+}
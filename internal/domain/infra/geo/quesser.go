@@ -2,75 +2,110 @@ package geo
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"net/http"
 	"net/url"
-	"time"
+	"strconv"
 
+	"github.com/chains-lab/places-svc/internal/domain/models"
 	"github.com/paulmach/orb"
 )
 
-type Address struct {
-	Formatted   string `json:"formatted"`
-	Country     string `json:"country"`
-	CountryCode string `json:"country_code"`
-	State       string `json:"state"`
-	City        string `json:"city"`
-	Suburb      string `json:"suburb"`
-	Street      string `json:"road"`
-	HouseNumber string `json:"house_number"`
-	Postcode    string `json:"postcode"`
-}
+// NominatimURL is the public Nominatim, see its usage policy before raising the rate.
+const NominatimURL = "https://nominatim.openstreetmap.org"
 
 type nominatimResp struct {
+	OsmType     string            `json:"osm_type"`
+	OsmID       int64             `json:"osm_id"`
+	Lat         string            `json:"lat"`
+	Lon         string            `json:"lon"`
 	DisplayName string            `json:"display_name"`
 	Address     map[string]string `json:"address"`
 }
 
+// Guesser geocodes with Nominatim, keeping to its rate limit. The API key, if any,
+// is sent as the key param used by hosted Nominatim services.
 type Guesser struct {
-	httpClient *http.Client
-	baseURL    string
-	userAgent  string
+	httpProvider
+	cfg ProviderConfig
 }
 
+var _ Provider = (*Guesser)(nil)
+
+// NewGuesser geocodes with the public Nominatim.
 func NewGuesser() *Guesser {
+	return NewNominatim(ProviderConfig{})
+}
+
+func NewNominatim(cfg ProviderConfig) *Guesser {
 	return &Guesser{
-		httpClient: &http.Client{Timeout: 5 * time.Second},
-		baseURL:    "https://nominatim.openstreetmap.org/reverse",
-		userAgent:  "chains-lab-places-svc",
+		httpProvider: newHTTPProvider(cfg, NominatimURL, NominatimInterval),
+		cfg:          cfg,
 	}
 }
 
-func (g *Guesser) Guess(ctx context.Context, pt orb.Point) (string, error) {
-	q := url.Values{}
+// Guess finds the address of the point, with the names in the locale where Nominatim has them.
+func (g *Guesser) Guess(ctx context.Context, pt orb.Point, locale string) (models.PlaceAddress, error) {
+	q := g.query(locale)
 	q.Set("lat", fmt.Sprintf("%f", pt[1]))
 	q.Set("lon", fmt.Sprintf("%f", pt[0]))
 	q.Set("format", "json")
-	q.Set("accept-language", "en")
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.baseURL+"?"+q.Encode(), nil)
-	if err != nil {
-		return "", err
+	var raw nominatimResp
+	if err := g.get(ctx, "/reverse", q, &raw); err != nil {
+		return models.PlaceAddress{}, err
 	}
-	req.Header.Set("User-Agent", g.userAgent)
 
-	resp, err := g.httpClient.Do(req)
-	if err != nil {
-		return "", err
+	return nominatimAddress(raw, locale), nil
+}
+
+// Locate finds the points matching the free-form address, the best match first.
+func (g *Guesser) Locate(ctx context.Context, query, locale string) ([]models.GeoCandidate, error) {
+	q := g.query(locale)
+	q.Set("q", query)
+	q.Set("format", "jsonv2")
+	q.Set("addressdetails", "1")
+	q.Set("limit", strconv.Itoa(LocateLimit))
+
+	var raw []nominatimResp
+	if err := g.get(ctx, "/search", q, &raw); err != nil {
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("geocode failed: %s", resp.Status)
+	res := make([]models.GeoCandidate, 0, len(raw))
+	for _, r := range raw {
+		lon, err := strconv.ParseFloat(r.Lon, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid lon %q in geocode result: %w", r.Lon, err)
+		}
+		lat, err := strconv.ParseFloat(r.Lat, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid lat %q in geocode result: %w", r.Lat, err)
+		}
+
+		res = append(res, models.GeoCandidate{
+			ID:      fmt.Sprintf("%s/%d", r.OsmType, r.OsmID),
+			Point:   orb.Point{lon, lat},
+			Address: nominatimAddress(r, locale),
+		})
 	}
 
-	var raw nominatimResp
-	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
-		return "", err
+	return res, nil
+}
+
+func (g *Guesser) query(locale string) url.Values {
+	q := url.Values{}
+	if lang := g.cfg.language(locale); lang != "" {
+		q.Set("accept-language", lang)
+	}
+	if g.cfg.APIKey != "" {
+		q.Set("key", g.cfg.APIKey)
 	}
+	return q
+}
 
-	addr := Address{
+func nominatimAddress(raw nominatimResp, locale string) models.PlaceAddress {
+	return models.PlaceAddress{
+		Locale:      locale,
 		Formatted:   raw.DisplayName,
 		Country:     raw.Address["country"],
 		CountryCode: raw.Address["country_code"],
@@ -81,15 +116,4 @@ func (g *Guesser) Guess(ctx context.Context, pt orb.Point) (string, error) {
 		HouseNumber: raw.Address["house_number"],
 		Postcode:    raw.Address["postcode"],
 	}
-
-	return fmt.Sprintf("%+v\n", addr), nil
-}
-
-func firstNonEmpty(values ...string) string {
-	for _, v := range values {
-		if v != "" {
-			return v
-		}
-	}
-	return ""
 }
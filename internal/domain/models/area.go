@@ -0,0 +1,179 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/planar"
+)
+
+// MaxAreaPoints limits the total number of vertices of a search area.
+const MaxAreaPoints = 2000
+
+// BBox is a lon/lat viewport. MinLon greater than MaxLon means the box crosses the antimeridian.
+type BBox struct {
+	MinLon float64
+	MinLat float64
+	MaxLon float64
+	MaxLat float64
+}
+
+// CrossesAntimeridian reports whether the box wraps from +180 to -180.
+func (b BBox) CrossesAntimeridian() bool {
+	return b.MinLon > b.MaxLon
+}
+
+func (b BBox) Validate() error {
+	for _, lon := range []float64{b.MinLon, b.MaxLon} {
+		if math.IsNaN(lon) || lon < -180 || lon > 180 {
+			return fmt.Errorf("longitude %v is out of [-180, 180]", lon)
+		}
+	}
+	for _, lat := range []float64{b.MinLat, b.MaxLat} {
+		if math.IsNaN(lat) || lat < -90 || lat > 90 {
+			return fmt.Errorf("latitude %v is out of [-90, 90]", lat)
+		}
+	}
+	if b.MinLat >= b.MaxLat {
+		return errors.New("min latitude must be less than max latitude")
+	}
+	if b.MinLon == b.MaxLon {
+		return errors.New("min and max longitude must differ")
+	}
+
+	return nil
+}
+
+// NormalizeArea checks that g is a polygon or a multipolygon usable as a search area and returns it as a multipolygon.
+// Every ring must be closed, have at least 4 points, a non-zero area and no self-intersections.
+// A ring crossing the antimeridian may come either with longitudes running past ±180 or wrapped around it,
+// in the latter case its longitudes are unwrapped so that the ring stays continuous.
+func NormalizeArea(g orb.Geometry) (orb.MultiPolygon, error) {
+	var mp orb.MultiPolygon
+	switch v := g.(type) {
+	case orb.Polygon:
+		mp = orb.MultiPolygon{v}
+	case orb.MultiPolygon:
+		mp = v
+	case nil:
+		return nil, errors.New("area is empty")
+	default:
+		return nil, fmt.Errorf("area must be a Polygon or a MultiPolygon, got %s", g.GeoJSONType())
+	}
+	if len(mp) == 0 {
+		return nil, errors.New("area is empty")
+	}
+
+	points := 0
+	out := make(orb.MultiPolygon, 0, len(mp))
+	for i, poly := range mp {
+		if len(poly) == 0 {
+			return nil, fmt.Errorf("polygon %d has no rings", i)
+		}
+
+		np := make(orb.Polygon, 0, len(poly))
+		for j, ring := range poly {
+			points += len(ring)
+			if points > MaxAreaPoints {
+				return nil, fmt.Errorf("area has more than %d points", MaxAreaPoints)
+			}
+
+			nr, err := normalizeRing(ring)
+			if err != nil {
+				return nil, fmt.Errorf("polygon %d ring %d: %w", i, j, err)
+			}
+			np = append(np, nr)
+		}
+		out = append(out, np)
+	}
+
+	return out, nil
+}
+
+func normalizeRing(ring orb.Ring) (orb.Ring, error) {
+	if len(ring) < 4 {
+		return nil, errors.New("ring must have at least 4 points")
+	}
+	if !ring.Closed() {
+		return nil, errors.New("ring is not closed")
+	}
+
+	out := make(orb.Ring, len(ring))
+	for i, pt := range ring {
+		lon, lat := pt[0], pt[1]
+		if math.IsNaN(lon) || math.IsNaN(lat) || lon < -360 || lon > 360 {
+			return nil, fmt.Errorf("longitude %v is out of range", lon)
+		}
+		if lat < -90 || lat > 90 {
+			return nil, fmt.Errorf("latitude %v is out of [-90, 90]", lat)
+		}
+
+		// соседние вершины дальше 180° друг от друга: кольцо пересекает антимеридиан, продолжаем долготу
+		if i > 0 {
+			prev := out[i-1][0]
+			for lon-prev > 180 {
+				lon -= 360
+			}
+			for prev-lon > 180 {
+				lon += 360
+			}
+		}
+		out[i] = orb.Point{lon, lat}
+	}
+
+	if out[0] != out[len(out)-1] {
+		return nil, errors.New("ring goes around the globe")
+	}
+	if b := out.Bound(); b.Max[0]-b.Min[0] >= 360 {
+		return nil, errors.New("ring spans the whole longitude range")
+	}
+	if planar.Area(out) == 0 {
+		return nil, errors.New("ring has zero area")
+	}
+	if ringSelfIntersects(out) {
+		return nil, errors.New("ring intersects itself")
+	}
+
+	return out, nil
+}
+
+func ringSelfIntersects(ring orb.Ring) bool {
+	n := len(ring) - 1 // последняя точка совпадает с первой
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			// соседние отрезки делят вершину, первый и последний тоже
+			if j == i+1 || (i == 0 && j == n-1) {
+				continue
+			}
+			if segmentsIntersect(ring[i], ring[i+1], ring[j], ring[j+1]) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func segmentsIntersect(a, b, c, d orb.Point) bool {
+	orient := func(p, q, r orb.Point) float64 {
+		return (q[0]-p[0])*(r[1]-p[1]) - (q[1]-p[1])*(r[0]-p[0])
+	}
+	onSegment := func(p, q, r orb.Point) bool {
+		return math.Min(p[0], q[0]) <= r[0] && r[0] <= math.Max(p[0], q[0]) &&
+			math.Min(p[1], q[1]) <= r[1] && r[1] <= math.Max(p[1], q[1])
+	}
+
+	d1, d2 := orient(c, d, a), orient(c, d, b)
+	d3, d4 := orient(a, b, c), orient(a, b, d)
+
+	if ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) && ((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0)) {
+		return true
+	}
+
+	return (d1 == 0 && onSegment(c, d, a)) ||
+		(d2 == 0 && onSegment(c, d, b)) ||
+		(d3 == 0 && onSegment(a, b, c)) ||
+		(d4 == 0 && onSegment(a, b, d))
+}
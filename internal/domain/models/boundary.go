@@ -0,0 +1,75 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paulmach/orb"
+)
+
+// Boundary is the area of a city or of a district of a city. The ID of a city is the city_id of its places,
+// CityID is set for districts only.
+type Boundary struct {
+	ID     uuid.UUID
+	Kind   string
+	CityID *uuid.UUID
+	Name   string
+	// Area is empty when the boundary is read without its geometry.
+	Area orb.MultiPolygon
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (b Boundary) IsNil() bool {
+	return b.ID == uuid.Nil
+}
+
+// BoundaryImportReport counts the boundaries stored by one import, a boundary already known is replaced.
+type BoundaryImportReport struct {
+	Cities    uint64
+	Districts uint64
+}
+
+// NormalizeBoundary checks that g is a polygon or a multipolygon usable as a boundary and returns it as a multipolygon.
+// Unlike NormalizeArea it takes any number of points, real city borders have many thousands of them.
+func NormalizeBoundary(g orb.Geometry) (orb.MultiPolygon, error) {
+	var mp orb.MultiPolygon
+	switch v := g.(type) {
+	case orb.Polygon:
+		mp = orb.MultiPolygon{v}
+	case orb.MultiPolygon:
+		mp = v
+	case nil:
+		return nil, errors.New("boundary is empty")
+	default:
+		return nil, fmt.Errorf("boundary must be a Polygon or a MultiPolygon, got %s", g.GeoJSONType())
+	}
+	if len(mp) == 0 {
+		return nil, errors.New("boundary is empty")
+	}
+
+	for i, poly := range mp {
+		if len(poly) == 0 {
+			return nil, fmt.Errorf("polygon %d has no rings", i)
+		}
+		for j, ring := range poly {
+			if len(ring) < 4 {
+				return nil, fmt.Errorf("polygon %d ring %d must have at least 4 points", i, j)
+			}
+			if !ring.Closed() {
+				return nil, fmt.Errorf("polygon %d ring %d is not closed", i, j)
+			}
+			for _, pt := range ring {
+				if math.IsNaN(pt[0]) || math.IsNaN(pt[1]) || pt[0] < -180 || pt[0] > 180 || pt[1] < -90 || pt[1] > 90 {
+					return nil, fmt.Errorf("polygon %d ring %d has point %v out of range", i, j, pt)
+				}
+			}
+		}
+	}
+
+	return mp, nil
+}
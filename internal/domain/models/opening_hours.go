@@ -0,0 +1,595 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// OpeningHours parser / serializer for the OpenStreetMap opening_hours syntax
+// (https://wiki.openstreetmap.org/wiki/Key:opening_hours/specification).
+//
+// Only the weekly subset that maps onto Timetable is supported:
+//
+//	24/7
+//	Mo-Fr 09:00-18:00; Sa 10:00-14:00
+//	Mo,We 08:00-12:00,13:00-17:00
+//	Fr 20:00-02:00          (overnight, also 20:00-26:00)
+//	Su off / Su closed      (later rule overrides earlier ones for its days)
+//	Mo-Fr 09:00-12:00, We 14:00-16:00   (additional rule, does not override)
+//	PH off                  (accepted and ignored, holidays are set as timetable exceptions)
+//
+// Month, week and year selectors, dates, sunrise/sunset, open ends, comments and
+// fallback rules are rejected with an OpeningHoursError pointing at the token.
+
+// OpeningHoursError tells which token of an opening_hours string could not be parsed.
+type OpeningHoursError struct {
+	// Pos is the byte offset of the token in the source string.
+	Pos    int
+	Token  string
+	Reason string
+}
+
+func (e *OpeningHoursError) Error() string {
+	if e.Token == "" {
+		return fmt.Sprintf("%s at position %d", e.Reason, e.Pos)
+	}
+
+	return fmt.Sprintf("%s: %q at position %d", e.Reason, e.Token, e.Pos)
+}
+
+var osmWeekdays = map[string]time.Weekday{
+	"Mo": time.Monday,
+	"Tu": time.Tuesday,
+	"We": time.Wednesday,
+	"Th": time.Thursday,
+	"Fr": time.Friday,
+	"Sa": time.Saturday,
+	"Su": time.Sunday,
+}
+
+// osmWeekOrder is the order of days in OSM ranges, the week starts on Monday.
+var osmWeekOrder = []time.Weekday{
+	time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday, time.Sunday,
+}
+
+const dayMinutes = 24 * 60
+
+type ohTokenKind int
+
+const (
+	ohWord ohTokenKind = iota
+	ohTime
+	ohAlways
+	ohPunct
+	ohOther
+)
+
+type ohToken struct {
+	kind ohTokenKind
+	text string
+	pos  int
+}
+
+func lexOpeningHours(s string) []ohToken {
+	var toks []ohToken
+	for i := 0; i < len(s); {
+		c := rune(s[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case unicode.IsLetter(c):
+			j := i
+			for j < len(s) && unicode.IsLetter(rune(s[j])) {
+				j++
+			}
+			toks = append(toks, ohToken{kind: ohWord, text: s[i:j], pos: i})
+			i = j
+		case unicode.IsDigit(c):
+			j := i
+			for j < len(s) && (unicode.IsDigit(rune(s[j])) || s[j] == ':' || s[j] == '/') {
+				j++
+			}
+			kind := ohOther
+			switch text := s[i:j]; {
+			case text == "24/7":
+				kind = ohAlways
+			case len(text) == 5 && text[2] == ':' && !strings.ContainsRune(text, '/'):
+				kind = ohTime
+			}
+			toks = append(toks, ohToken{kind: kind, text: s[i:j], pos: i})
+			i = j
+		case c == '"':
+			j := strings.IndexByte(s[i+1:], '"')
+			if j < 0 {
+				j = len(s)
+			} else {
+				j += i + 2
+			}
+			toks = append(toks, ohToken{kind: ohOther, text: s[i:j], pos: i})
+			i = j
+		case c == '|' && i+1 < len(s) && s[i+1] == '|':
+			toks = append(toks, ohToken{kind: ohOther, text: "||", pos: i})
+			i += 2
+		default:
+			toks = append(toks, ohToken{kind: ohPunct, text: s[i : i+1], pos: i})
+			i++
+		}
+	}
+
+	return toks
+}
+
+type ohSpan struct {
+	from, to int // minutes from the midnight of the owning day, to may go past 24:00
+}
+
+type ohParser struct {
+	toks []ohToken
+	i    int
+	end  int
+}
+
+func (p *ohParser) done() bool {
+	return p.i >= len(p.toks)
+}
+
+func (p *ohParser) peek(offset int) (ohToken, bool) {
+	if p.i+offset >= len(p.toks) {
+		return ohToken{}, false
+	}
+
+	return p.toks[p.i+offset], true
+}
+
+func (p *ohParser) next() (ohToken, bool) {
+	t, ok := p.peek(0)
+	if ok {
+		p.i++
+	}
+
+	return t, ok
+}
+
+func (p *ohParser) fail(t ohToken, reason string) error {
+	return &OpeningHoursError{Pos: t.pos, Token: t.text, Reason: reason}
+}
+
+func (p *ohParser) failEnd(reason string) error {
+	return &OpeningHoursError{Pos: p.end, Reason: reason}
+}
+
+func isOSMWeekday(t ohToken) bool {
+	_, ok := osmWeekdays[t.text]
+	return t.kind == ohWord && ok
+}
+
+func isOSMHoliday(t ohToken) bool {
+	return t.kind == ohWord && t.text == "PH"
+}
+
+func isOSMClosed(t ohToken) bool {
+	return t.kind == ohWord && (t.text == "off" || t.text == "closed")
+}
+
+// ParseOpeningHours converts an OSM opening_hours string into a weekly Timetable.
+// Overlapping and touching intervals are merged, so the result always passes timetable validation.
+func ParseOpeningHours(s string) (Timetable, error) {
+	p := &ohParser{toks: lexOpeningHours(s), end: len(s)}
+	if p.done() {
+		return Timetable{}, p.failEnd("empty opening_hours")
+	}
+
+	var week [7][]ohSpan
+	additional := false
+	for !p.done() {
+		if err := p.rule(&week, additional); err != nil {
+			return Timetable{}, err
+		}
+		if p.done() {
+			break
+		}
+
+		sep, _ := p.next()
+		switch {
+		case sep.kind == ohPunct && sep.text == ";":
+			additional = false
+		case sep.kind == ohPunct && sep.text == ",":
+			additional = true
+			if p.done() {
+				return Timetable{}, p.failEnd("rule expected after ','")
+			}
+		default:
+			return Timetable{}, p.fail(sep, "unsupported token")
+		}
+	}
+
+	var open [WeekMinutes]bool
+	for wd, spans := range week {
+		for _, span := range spans {
+			for m := span.from; m < span.to; m++ {
+				open[(wd*dayMinutes+m)%WeekMinutes] = true
+			}
+		}
+	}
+
+	return timetableFromWeek(&open), nil
+}
+
+// rule parses one "<selector> <hours>" rule and applies it to week.
+// A normal rule replaces the hours of its days, an additional one (after ',') adds to them.
+func (p *ohParser) rule(week *[7][]ohSpan, additional bool) error {
+	var days [7]bool
+	var holiday *ohToken
+
+	first, _ := p.peek(0)
+	switch {
+	case first.kind == ohAlways:
+		p.next()
+		days = [7]bool{true, true, true, true, true, true, true}
+	case isOSMWeekday(first) || isOSMHoliday(first):
+		var err error
+		days, holiday, err = p.weekdays()
+		if err != nil {
+			return err
+		}
+	case first.kind == ohTime || isOSMClosed(first):
+		days = [7]bool{true, true, true, true, true, true, true}
+	case first.kind == ohPunct && first.text == ";":
+		return p.fail(first, "empty rule")
+	default:
+		return p.fail(first, "unsupported selector")
+	}
+
+	var spans []ohSpan
+	closed := false
+
+	t, ok := p.peek(0)
+	switch {
+	case !ok, t.kind == ohPunct && (t.text == ";" || t.text == ","):
+		spans = []ohSpan{{from: 0, to: dayMinutes}}
+	case isOSMClosed(t):
+		p.next()
+		closed = true
+	case t.kind == ohWord && t.text == "open":
+		p.next()
+		spans = []ohSpan{{from: 0, to: dayMinutes}}
+	case t.kind == ohTime:
+		var err error
+		if spans, err = p.timeSpans(); err != nil {
+			return err
+		}
+	default:
+		return p.fail(t, "unsupported token")
+	}
+
+	if holiday != nil && !closed {
+		return p.fail(*holiday, "public holiday hours are not supported, use timetable exceptions")
+	}
+
+	for wd, selected := range days {
+		if !selected {
+			continue
+		}
+		if additional {
+			week[wd] = append(week[wd], spans...)
+		} else {
+			week[wd] = append([]ohSpan(nil), spans...)
+		}
+	}
+
+	return nil
+}
+
+// weekdays parses a list like "Mo-Fr,Su" or "Sa,PH". Ranges may wrap over the end of the week ("Fr-Mo").
+func (p *ohParser) weekdays() (days [7]bool, holiday *ohToken, err error) {
+	for {
+		t, _ := p.next()
+		switch {
+		case isOSMHoliday(t):
+			holiday = &t
+		case isOSMWeekday(t):
+			from := osmWeekdays[t.text]
+			to := from
+
+			if dash, ok := p.peek(0); ok && dash.kind == ohPunct && dash.text == "-" {
+				p.next()
+				last, ok := p.next()
+				if !ok {
+					return days, nil, p.failEnd("weekday expected after '-'")
+				}
+				if !isOSMWeekday(last) {
+					return days, nil, p.fail(last, "weekday expected")
+				}
+				to = osmWeekdays[last.text]
+			}
+
+			for d := from; ; d = (d + 1) % 7 {
+				days[d] = true
+				if d == to {
+					break
+				}
+			}
+		default:
+			return days, nil, p.fail(t, "weekday expected")
+		}
+
+		comma, ok := p.peek(0)
+		if !ok || comma.kind != ohPunct || comma.text != "," {
+			return days, holiday, nil
+		}
+		if t, ok := p.peek(1); !ok || !(isOSMWeekday(t) || isOSMHoliday(t)) {
+			return days, holiday, nil
+		}
+		p.next()
+	}
+}
+
+// timeSpans parses "09:00-12:00,13:00-18:00". An end before the start, or past 24:00, runs into the next day.
+func (p *ohParser) timeSpans() ([]ohSpan, error) {
+	var spans []ohSpan
+	for {
+		start, _ := p.next()
+		from, err := p.clock(start, 24*60-1)
+		if err != nil {
+			return nil, err
+		}
+
+		dash, ok := p.next()
+		switch {
+		case !ok:
+			return nil, p.failEnd("'-' expected after time")
+		case dash.kind == ohPunct && dash.text == "+":
+			return nil, p.fail(dash, "open end is not supported")
+		case dash.kind != ohPunct || dash.text != "-":
+			return nil, p.fail(dash, "'-' expected after time")
+		}
+
+		end, ok := p.next()
+		if !ok {
+			return nil, p.failEnd("time expected after '-'")
+		}
+		if end.kind != ohTime {
+			return nil, p.fail(end, "time expected")
+		}
+		to, err := p.clock(end, 2*dayMinutes)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case to == from:
+			return nil, p.fail(end, "interval is empty")
+		case to < from:
+			to += dayMinutes
+		}
+		if to-from > dayMinutes {
+			return nil, p.fail(end, "interval is longer than 24 hours")
+		}
+		spans = append(spans, ohSpan{from: from, to: to})
+
+		comma, ok := p.peek(0)
+		if !ok || comma.kind != ohPunct || comma.text != "," {
+			return spans, nil
+		}
+		if t, ok := p.peek(1); !ok || t.kind != ohTime {
+			return spans, nil
+		}
+		p.next()
+	}
+}
+
+// clock parses a HH:MM token into minutes, limit is the largest accepted value.
+func (p *ohParser) clock(t ohToken, limit int) (int, error) {
+	if t.kind != ohTime {
+		return 0, p.fail(t, "time expected")
+	}
+
+	var hh, mm int
+	if _, err := fmt.Sscanf(t.text, "%2d:%2d", &hh, &mm); err != nil || mm > 59 {
+		return 0, p.fail(t, "invalid time")
+	}
+	minutes := hh*60 + mm
+	if minutes > limit {
+		return 0, p.fail(t, "time out of range")
+	}
+
+	return minutes, nil
+}
+
+// timetableFromWeek turns a minute map of the week into intervals, glueing runs across midnight
+// and across the end of the week. A place open all week gets one interval per day.
+func timetableFromWeek(open *[WeekMinutes]bool) Timetable {
+	runs, always := weekRuns(open)
+
+	tt := Timetable{Table: make([]TimeInterval, 0, len(runs))}
+	if always {
+		for d := 0; d < 7; d++ {
+			tt.Table = append(tt.Table, TimeInterval{
+				From: NumberMinutesToMoment(d * dayMinutes),
+				To:   NumberMinutesToMoment((d + 1) * dayMinutes),
+			})
+		}
+		return tt
+	}
+
+	for _, r := range runs {
+		tt.Table = append(tt.Table, TimeInterval{
+			From: NumberMinutesToMoment(r.from),
+			To:   NumberMinutesToMoment(r.to),
+		})
+	}
+	sort.Slice(tt.Table, func(i, j int) bool {
+		return tt.Table[i].From.ToNumberMinutes() < tt.Table[j].From.ToNumberMinutes()
+	})
+
+	return tt
+}
+
+// weekRuns returns the open runs of the week as [from, to) minutes, to may exceed WeekMinutes
+// for a run that wraps over Saturday → Sunday. always is true when there is no closed minute.
+func weekRuns(open *[WeekMinutes]bool) (runs []ohSpan, always bool) {
+	start := -1
+	for m := range open {
+		if !open[m] {
+			start = m
+			break
+		}
+	}
+	if start < 0 {
+		return nil, true
+	}
+
+	runStart := -1
+	for i := start + 1; i <= start+WeekMinutes; i++ {
+		m := i % WeekMinutes
+		switch {
+		case open[m] && runStart < 0:
+			runStart = i
+		case !open[m] && runStart >= 0:
+			from := runStart % WeekMinutes
+			runs = append(runs, ohSpan{from: from, to: from + i - runStart})
+			runStart = -1
+		}
+	}
+
+	return runs, false
+}
+
+// OpeningHours renders the weekly part of the timetable in OSM opening_hours syntax.
+// Days with equal hours are grouped ("Mo-Fr 09:00-18:00"), intervals that end on the next
+// day are written as overnight spans ("Fr 20:00-02:00"). Date exceptions are not included.
+func (t Timetable) OpeningHours() string {
+	week, always := t.daySpans()
+	if always {
+		return "24/7"
+	}
+
+	specs := make(map[time.Weekday]string, 7)
+	for _, wd := range osmWeekOrder {
+		spans := week[wd]
+		if len(spans) == 0 {
+			continue
+		}
+
+		parts := make([]string, 0, len(spans))
+		for _, span := range spans {
+			parts = append(parts, formatOSMClock(span.from)+"-"+formatOSMClock(span.to))
+		}
+		specs[wd] = strings.Join(parts, ",")
+	}
+
+	// группируем подряд идущие дни с одинаковыми часами, а одинаковые группы собираем через запятую
+	type group struct {
+		selectors []string
+		spec      string
+	}
+	var groups []*group
+	bySpec := make(map[string]*group)
+
+	for i := 0; i < len(osmWeekOrder); {
+		spec, ok := specs[osmWeekOrder[i]]
+		if !ok {
+			i++
+			continue
+		}
+
+		j := i
+		for j+1 < len(osmWeekOrder) && specs[osmWeekOrder[j+1]] == spec {
+			j++
+		}
+
+		selector := osmWeekdayName(osmWeekOrder[i])
+		if j > i {
+			selector += "-" + osmWeekdayName(osmWeekOrder[j])
+		}
+
+		g, ok := bySpec[spec]
+		if !ok {
+			g = &group{spec: spec}
+			bySpec[spec] = g
+			groups = append(groups, g)
+		}
+		g.selectors = append(g.selectors, selector)
+
+		i = j + 1
+	}
+
+	if len(groups) == 0 {
+		return "off"
+	}
+
+	rules := make([]string, 0, len(groups))
+	for _, g := range groups {
+		rules = append(rules, strings.Join(g.selectors, ",")+" "+g.spec)
+	}
+
+	return strings.Join(rules, "; ")
+}
+
+// daySpans lays the weekly intervals out per weekday as [from, to) minutes from midnight, ordered by from.
+// An overnight run stays on the day it starts and its to exceeds a day, a run longer than a day is cut at
+// midnights. always is true when the place never closes.
+func (t Timetable) daySpans() (week [7][]ohSpan, always bool) {
+	var open [WeekMinutes]bool
+	for _, interval := range t.Table {
+		start, end := interval.ToNumberMinutes()
+		if end <= start {
+			end += WeekMinutes
+		}
+		for m := start; m < end; m++ {
+			open[m%WeekMinutes] = true
+		}
+	}
+
+	runs, always := weekRuns(&open)
+	if always {
+		return week, true
+	}
+
+	for _, r := range runs {
+		from, length := r.from, r.to-r.from
+		day, offset := from/dayMinutes, from%dayMinutes
+
+		if length < dayMinutes {
+			week[day] = append(week[day], ohSpan{from: offset, to: offset + length})
+			continue
+		}
+
+		// дольше суток: режем по полуночам, при разборе куски снова склеятся
+		for length > 0 {
+			piece := min(length, dayMinutes-offset)
+			week[day] = append(week[day], ohSpan{from: offset, to: offset + piece})
+			length -= piece
+			day, offset = (day+1)%7, 0
+		}
+	}
+
+	for d := range week {
+		spans := week[d]
+		sort.Slice(spans, func(i, j int) bool { return spans[i].from < spans[j].from })
+	}
+
+	return week, false
+}
+
+// formatOSMClock writes minutes from midnight as HH:MM, an end past midnight wraps ("02:00"), midnight as an end is "24:00".
+func formatOSMClock(minutes int) string {
+	if minutes > dayMinutes {
+		minutes -= dayMinutes
+	}
+
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
+
+func osmWeekdayName(wd time.Weekday) string {
+	for name, d := range osmWeekdays {
+		if d == wd {
+			return name
+		}
+	}
+
+	return ""
+}
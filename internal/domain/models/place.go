@@ -1,6 +1,7 @@
 package models
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -19,8 +20,11 @@ type PlaceDetails struct {
 	Point     orb.Point `json:"point"`
 	Address   string    `json:"address"`
 
-	Website *string `json:"website"`
-	Phone   *string `json:"phone"`
+	Website  *string `json:"website"`
+	Phone    *string `json:"phone"`
+	Timezone string  `json:"timezone"`
+
+	AddressStatus string `json:"address_status"`
 
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
@@ -57,13 +61,25 @@ type Place struct {
 	Name        string `json:"name"`
 	Description string `json:"description"`
 
-	Website *string `json:"website"`
-	Phone   *string `json:"phone"`
+	Website  *string `json:"website"`
+	Phone    *string `json:"phone"`
+	Timezone string  `json:"timezone"`
+
+	// AddressStatus is the state of the address lookup, AddressDetails are set once it is resolved.
+	AddressStatus string `json:"address_status"`
 
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 
 	Timetable Timetable
+
+	// Rank is the full-text search relevance, Snippet is the matched text with the query words marked.
+	// Both are set only for places found by a search query.
+	Rank    *float64 `json:"rank,omitempty"`
+	Snippet *string  `json:"snippet,omitempty"`
+
+	// AddressDetails is the structured address in the place locale, nil until the place is geocoded.
+	AddressDetails *PlaceAddress `json:"address_details,omitempty"`
 }
 
 func (p Place) IsNil() bool {
@@ -83,16 +99,77 @@ func (p Place) Details() PlaceDetails {
 		Address:   p.Address,
 		Website:   p.Website,
 		Phone:     p.Phone,
+		Timezone:  p.Timezone,
 		CreatedAt: p.CreatedAt,
 		UpdatedAt: p.UpdatedAt,
+
+		AddressStatus: p.AddressStatus,
 	}
 }
 
+// PlaceAddress is the structured address of a place in one locale, as found by reverse geocoding of its point.
+type PlaceAddress struct {
+	PlaceID     uuid.UUID `json:"place_id"`
+	Locale      string    `json:"locale"`
+	Formatted   string    `json:"formatted"`
+	Country     string    `json:"country"`
+	CountryCode string    `json:"country_code"`
+	State       string    `json:"state"`
+	City        string    `json:"city"`
+	Suburb      string    `json:"suburb"`
+	Street      string    `json:"street"`
+	HouseNumber string    `json:"house_number"`
+	Postcode    string    `json:"postcode"`
+}
+
+// GeoCandidate is a point found by forward geocoding of an address, ID is the reference of the provider.
+type GeoCandidate struct {
+	ID      string       `json:"id"`
+	Point   orb.Point    `json:"point"`
+	Address PlaceAddress `json:"address"`
+}
+
+// AmbiguousAddress is the error of an address that matches several distinct points.
+type AmbiguousAddress struct {
+	Address    string
+	Candidates []GeoCandidate
+}
+
+func (e *AmbiguousAddress) Error() string {
+	return fmt.Sprintf("address %q matches %d places", e.Address, len(e.Candidates))
+}
+
+// PlaceAddressJob is a place claimed by the address lookup, to be geocoded in each of its Locales.
+// Lease identifies the claim, see place.Service.EnrichAddresses.
+type PlaceAddressJob struct {
+	PlaceID  uuid.UUID
+	Point    orb.Point
+	Address  string
+	Attempts int
+	Locales  []string
+	Lease    time.Time
+}
+
 type PlacesCollection struct {
 	Data  []Place `json:"data"`
 	Page  uint64  `json:"page"`
 	Size  uint64  `json:"size"`
 	Total uint64  `json:"total"`
+
+	// TotalSkipped is set when the total was not counted on request, Total is zero then.
+	TotalSkipped bool `json:"total_skipped,omitempty"`
+	// NextCursor continues a keyset listing, it is empty on the last page and for numbered pages.
+	NextCursor string `json:"next_cursor,omitempty"`
+
+	// Facets are counted on request only.
+	Facets *PlaceFacets `json:"facets,omitempty"`
+}
+
+// PlaceExport is a place with all its localizations and the weekly timetable in force today.
+type PlaceExport struct {
+	PlaceDetails
+	Locales   []PlaceLocale `json:"locales"`
+	Timetable Timetable     `json:"timetable"`
 }
 
 type PlaceLocaleCollection struct {
@@ -101,3 +178,36 @@ type PlaceLocaleCollection struct {
 	Size  uint64        `json:"size"`
 	Total uint64        `json:"total"`
 }
+
+// PlaceSuggestion is a lightweight search box hint, DistanceM is set only when suggestions are made near a point.
+type PlaceSuggestion struct {
+	ID        uuid.UUID `json:"id"`
+	Class     string    `json:"class"`
+	Locale    string    `json:"locale"`
+	Name      string    `json:"name"`
+	DistanceM *float64  `json:"distance_m,omitempty"`
+}
+
+// PlaceFacetCount is the number of places with a facet value, a class counts the places of all its subclasses too.
+type PlaceFacetCount struct {
+	Value string
+	Count uint64
+}
+
+// PlaceFacets are the counts of places per facet value, only the requested facets are set.
+type PlaceFacets struct {
+	Class    []PlaceFacetCount
+	Status   []PlaceFacetCount
+	Verified []PlaceFacetCount
+	OpenNow  *uint64
+}
+
+// PlaceCluster is a map grid cell with places, PlaceIDs are listed only for small clusters.
+type PlaceCluster struct {
+	Zoom     uint
+	CellX    int64
+	CellY    int64
+	Centroid orb.Point
+	Count    uint64
+	PlaceIDs []uuid.UUID
+}
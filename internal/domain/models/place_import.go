@@ -0,0 +1,21 @@
+package models
+
+import "github.com/google/uuid"
+
+// PlaceImportRow is the outcome of one row of a bulk import, Row is the row number in the imported file.
+type PlaceImportRow struct {
+	Row     int
+	Result  string
+	PlaceID *uuid.UUID
+	Reason  string
+}
+
+// PlaceImportReport sums up a bulk import. In a dry run nothing is stored,
+// but every row goes through the same checks and the report is what a real import would return.
+type PlaceImportReport struct {
+	DryRun  bool
+	Created uint64
+	Updated uint64
+	Failed  uint64
+	Rows    []PlaceImportRow
+}
@@ -1,6 +1,16 @@
 package models
 
-import "time"
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WeekMinutes is the length of the week in minutes, moments are stored as offsets in [0, WeekMinutes)
+// counted from Sunday 00:00. An interval whose end is before its start wraps over Saturday → Sunday.
+const WeekMinutes = 7 * 24 * 60
 
 type Moment struct {
 	Weekday time.Weekday
@@ -12,17 +22,121 @@ type TimeInterval struct {
 	To   Moment
 }
 
+// DefaultTimetableName names the weekly timetable of a place that has no validity dates set.
+const DefaultTimetableName = "default"
+
+// Timetable is a named weekly timetable of a place. ValidFrom / ValidTo bound the dates
+// (inclusive) it is in force, nil means unbounded, so a timetable without dates is the fallback.
+// TemplateID is set when the intervals are kept in sync with a company timetable template.
 type Timetable struct {
-	Table []TimeInterval
+	Name       string
+	ValidFrom  *time.Time
+	ValidTo    *time.Time
+	TemplateID *uuid.UUID
+
+	Table      []TimeInterval
+	Exceptions []TimetableException
+}
+
+// ValidOn reports whether the timetable is in force on the calendar date of date.
+func (t Timetable) ValidOn(date time.Time) bool {
+	d := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+	if t.ValidFrom != nil && d.Before(*t.ValidFrom) {
+		return false
+	}
+	if t.ValidTo != nil && d.After(*t.ValidTo) {
+		return false
+	}
+
+	return true
+}
+
+// ActiveTimetable picks the timetable in force on the date: among the valid ones the one that
+// started last wins (a timetable without ValidFrom counts as the earliest), then the one that ends
+// first, then by name. It returns false when no timetable covers the date. The filters by time pick
+// the timetable in SQL by the same rules.
+func ActiveTimetable(timetables []Timetable, date time.Time) (Timetable, bool) {
+	var (
+		best  Timetable
+		found bool
+	)
+	for _, t := range timetables {
+		if !t.ValidOn(date) {
+			continue
+		}
+		if !found || activeBefore(t, best) {
+			best, found = t, true
+		}
+	}
+
+	return best, found
+}
+
+func activeBefore(a, b Timetable) bool {
+	switch {
+	case (a.ValidFrom == nil) != (b.ValidFrom == nil):
+		return a.ValidFrom != nil
+	case a.ValidFrom != nil && !a.ValidFrom.Equal(*b.ValidFrom):
+		return a.ValidFrom.After(*b.ValidFrom)
+	case (a.ValidTo == nil) != (b.ValidTo == nil):
+		return a.ValidTo != nil
+	case a.ValidTo != nil && !a.ValidTo.Equal(*b.ValidTo):
+		return a.ValidTo.Before(*b.ValidTo)
+	}
+
+	return a.Name < b.Name
+}
+
+// TimetableException overrides the weekly timetable for a single calendar date.
+// An exception without intervals means the place is closed for the whole day.
+type TimetableException struct {
+	Date      time.Time
+	Reason    *string
+	Intervals []DayInterval
 }
 
+func (e TimetableException) Closed() bool {
+	return len(e.Intervals) == 0
+}
+
+// DayInterval is a time range inside one day, From and To are offsets from midnight.
+type DayInterval struct {
+	From time.Duration
+	To   time.Duration
+}
+
+// TimetableStatus tells whether a place is open at the instant At and when that changes next.
+// All times are in the place local time zone. NextOpen / NextClose are nil when no such
+// change happens within the lookahead window (e.g. a place open 24/7 never closes).
+type TimetableStatus struct {
+	At        time.Time
+	Open      bool
+	NextOpen  *time.Time
+	NextClose *time.Time
+}
+
+// MinutesLeft returns the number of whole minutes until the place closes (when open) or opens (when closed).
+func (s TimetableStatus) MinutesLeft() *int {
+	next := s.NextOpen
+	if s.Open {
+		next = s.NextClose
+	}
+	if next == nil {
+		return nil
+	}
+
+	minutes := int(next.Sub(s.At) / time.Minute)
+	return &minutes
+}
+
+// NumberMinutesToMoment is the inverse of Moment.ToNumberMinutes, values outside
+// [0, WeekMinutes) are wrapped onto the week.
 func NumberMinutesToMoment(minutes int) Moment {
-	weekday := time.Weekday(minutes / (60 * 24))
-	hour := (minutes % (60 * 24)) / 60
+	minutes = ((minutes % WeekMinutes) + WeekMinutes) % WeekMinutes
 
 	return Moment{
-		Weekday: weekday,
-		Time:    time.Duration(hour) * time.Hour,
+		Weekday: time.Weekday(minutes / (24 * 60)),
+		Time:    time.Duration(minutes%(24*60)) * time.Minute,
 	}
 }
 
@@ -33,6 +147,49 @@ func (ti TimeInterval) ToNumberMinutes() (int, int) {
 	return from, to
 }
 
+// ToNumberMinutes returns the moment as whole minutes since Sunday 00:00, seconds are dropped.
 func (m Moment) ToNumberMinutes() int {
-	return int(m.Weekday)*24*60 + int(m.Time.Hours())*60 + int(m.Time.Minutes())%60
+	return int(m.Weekday)*24*60 + int(m.Time/time.Minute)
+}
+
+// ValidateWeekTable checks that every interval is non-empty and that no two intervals overlap.
+// Intervals may cross midnight and the end of the week (Saturday → Sunday), so the check is done
+// on the week circle: a wrapping interval is cut into two linear pieces first.
+func ValidateWeekTable(table []TimeInterval) error {
+	type span struct{ start, end, idx int }
+
+	spans := make([]span, 0, len(table)+1)
+	for i, interval := range table {
+		for _, m := range []Moment{interval.From, interval.To} {
+			if m.Weekday < time.Sunday || m.Weekday > time.Saturday || m.Time < 0 || m.Time >= 24*time.Hour {
+				return fmt.Errorf("interval %d: moment %s %s is out of the week", i, m.Weekday, m.Time)
+			}
+		}
+
+		start, end := interval.ToNumberMinutes()
+		switch {
+		case start == end:
+			return fmt.Errorf("interval %d is empty", i)
+		case start < end:
+			spans = append(spans, span{start: start, end: end, idx: i})
+		default:
+			spans = append(spans, span{start: start, end: WeekMinutes, idx: i})
+			if end > 0 {
+				spans = append(spans, span{start: 0, end: end, idx: i})
+			}
+		}
+	}
+
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
+	for i := 1; i < len(spans); i++ {
+		if spans[i].start < spans[i-1].end {
+			a, b := spans[i-1].idx, spans[i].idx
+			if a > b {
+				a, b = b, a
+			}
+			return fmt.Errorf("intervals %d and %d overlap", a, b)
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,113 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/chains-lab/places-svc/internal/domain/enum"
+)
+
+type summaryLocale struct {
+	days   [7]string // по time.Weekday, начиная с воскресенья
+	closed string
+	always string
+	am, pm string
+}
+
+var summaryLocales = map[string]summaryLocale{
+	enum.LocaleEN: {
+		days:   [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"},
+		closed: "closed",
+		always: "open 24/7",
+		am:     "AM",
+		pm:     "PM",
+	},
+	enum.LocaleRU: {
+		days:   [7]string{"Вс", "Пн", "Вт", "Ср", "Чт", "Пт", "Сб"},
+		closed: "выходной",
+		always: "круглосуточно",
+		am:     "AM",
+		pm:     "PM",
+	},
+	enum.LocaleUK: {
+		days:   [7]string{"Нд", "Пн", "Вт", "Ср", "Чт", "Пт", "Сб"},
+		closed: "вихідний",
+		always: "цілодобово",
+		am:     "дп",
+		pm:     "пп",
+	},
+}
+
+// Summary renders the weekly part of the timetable as a short human-readable line in the given locale
+// (enum.DefaultLocale when it is not supported), e.g. "Mon–Fri 09:00–18:00, Sat 10:00–14:00, Sun closed".
+// The week starts on Monday, consecutive days with equal hours are grouped, several intervals of one day
+// are joined with " / " and an overnight interval stays on the day it starts. hour12 switches to the
+// 12-hour clock ("9:00 AM–6:00 PM"). Date exceptions are not included.
+func (t Timetable) Summary(locale string, hour12 bool) string {
+	loc, ok := summaryLocales[locale]
+	if !ok {
+		loc = summaryLocales[enum.DefaultLocale]
+	}
+
+	week, always := t.daySpans()
+	if always {
+		return loc.always
+	}
+
+	specs := make([]string, 0, len(osmWeekOrder))
+	for _, wd := range osmWeekOrder {
+		spans := week[wd]
+		if len(spans) == 0 {
+			specs = append(specs, loc.closed)
+			continue
+		}
+
+		parts := make([]string, 0, len(spans))
+		for _, span := range spans {
+			parts = append(parts, loc.clock(span.from, hour12)+"–"+loc.clock(span.to, hour12))
+		}
+		specs = append(specs, strings.Join(parts, " / "))
+	}
+
+	groups := make([]string, 0, len(specs))
+	for i := 0; i < len(specs); {
+		j := i
+		for j+1 < len(specs) && specs[j+1] == specs[i] {
+			j++
+		}
+
+		days := loc.days[osmWeekOrder[i]]
+		if j > i {
+			days += "–" + loc.days[osmWeekOrder[j]]
+		}
+		groups = append(groups, days+" "+specs[i])
+
+		i = j + 1
+	}
+
+	return strings.Join(groups, ", ")
+}
+
+// clock writes minutes from midnight, an end past midnight wraps onto the next day and midnight
+// as an end is "24:00" (or "12:00 AM" on the 12-hour clock).
+func (l summaryLocale) clock(minutes int, hour12 bool) string {
+	if minutes > dayMinutes {
+		minutes -= dayMinutes
+	}
+	h, m := minutes/60, minutes%60
+
+	if !hour12 {
+		return fmt.Sprintf("%02d:%02d", h, m)
+	}
+
+	suffix := l.am
+	if h%24 >= 12 {
+		suffix = l.pm
+	}
+	h %= 12
+	if h == 0 {
+		h = 12
+	}
+
+	return fmt.Sprintf("%d:%02d %s", h, m, suffix)
+}
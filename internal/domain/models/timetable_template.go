@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TimetableTemplate is a weekly timetable owned by a company that the company's places can link to.
+// A linked place keeps a copy of the template intervals which is rewritten whenever the template changes.
+type TimetableTemplate struct {
+	ID        uuid.UUID      `json:"id"`
+	CompanyID uuid.UUID      `json:"company_id"`
+	Name      string         `json:"name"`
+	Table     []TimeInterval `json:"table"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+func (t TimetableTemplate) IsNil() bool {
+	return t.ID == uuid.Nil
+}
+
+type TimetableTemplatesCollection struct {
+	Data  []TimetableTemplate `json:"data"`
+	Page  uint64              `json:"page"`
+	Size  uint64              `json:"size"`
+	Total uint64              `json:"total"`
+}
@@ -0,0 +1,77 @@
+package boundary
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/google/uuid"
+	"github.com/paulmach/orb/geojson"
+)
+
+// DecodeGeoJSON reads boundaries from a FeatureCollection of Polygon and MultiPolygon features.
+// The id is the feature id or the "id" property, "kind" is city or district, kind is used when
+// a feature has none. A district has the "city_id" of its city, "name" is optional.
+func DecodeGeoJSON(r io.Reader, kind string) ([]models.Boundary, error) {
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read geojson: %w", err)
+	}
+
+	fc, err := geojson.UnmarshalFeatureCollection(raw)
+	if err != nil {
+		return nil, fmt.Errorf("decode geojson feature collection: %w", err)
+	}
+
+	res := make([]models.Boundary, 0, len(fc.Features))
+	for i, f := range fc.Features {
+		b, err := decodeFeature(f, kind)
+		if err != nil {
+			return nil, fmt.Errorf("feature %d: %w", i+1, err)
+		}
+		res = append(res, b)
+	}
+
+	return res, nil
+}
+
+func decodeFeature(f *geojson.Feature, kind string) (models.Boundary, error) {
+	get := func(name string) string {
+		if v, ok := f.Properties[name].(string); ok {
+			return strings.TrimSpace(v)
+		}
+		return ""
+	}
+
+	rawID := get("id")
+	if id, ok := f.ID.(string); ok && id != "" {
+		rawID = id
+	}
+	id, err := uuid.Parse(rawID)
+	if err != nil {
+		return models.Boundary{}, fmt.Errorf("invalid id %q", rawID)
+	}
+
+	b := models.Boundary{
+		ID:   id,
+		Kind: kind,
+		Name: get("name"),
+	}
+	if k := get("kind"); k != "" {
+		b.Kind = k
+	}
+	if v := get("city_id"); v != "" {
+		cityID, err := uuid.Parse(v)
+		if err != nil {
+			return models.Boundary{}, fmt.Errorf("invalid city_id %q", v)
+		}
+		b.CityID = &cityID
+	}
+
+	if b.Area, err = models.NormalizeBoundary(f.Geometry); err != nil {
+		return models.Boundary{}, err
+	}
+
+	return b, nil
+}
@@ -0,0 +1,118 @@
+package boundary
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+	"unicode/utf8"
+
+	"github.com/chains-lab/places-svc/internal/domain/enum"
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+// maxNameLength is the length of boundaries.name.
+const maxNameLength = 255
+
+// Import stores the boundaries in one transaction, a boundary already known is replaced.
+// A district must belong to a city known before the import or imported with it.
+func (s Service) Import(ctx context.Context, boundaries []models.Boundary) (models.BoundaryImportReport, error) {
+	cities := make(map[uuid.UUID]bool, len(boundaries))
+	for i, b := range boundaries {
+		if err := validateBoundary(b); err != nil {
+			return models.BoundaryImportReport{}, errx.ErrorInvalidBoundary.Raise(
+				fmt.Errorf("boundary %d is invalid, cause: %w", i+1, err),
+			)
+		}
+		if b.Kind == enum.BoundaryKindCity {
+			cities[b.ID] = true
+		}
+	}
+
+	// города раньше районов, иначе внешний ключ района не на что ссылать
+	sorted := make([]models.Boundary, len(boundaries))
+	copy(sorted, boundaries)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Kind == enum.BoundaryKindCity && sorted[j].Kind != enum.BoundaryKindCity
+	})
+
+	now := time.Now().UTC()
+	var report models.BoundaryImportReport
+
+	err := s.db.Transaction(ctx, func(ctx context.Context) error {
+		for _, b := range sorted {
+			if b.Kind == enum.BoundaryKindDistrict && !cities[*b.CityID] {
+				if err := s.checkCity(ctx, *b.CityID); err != nil {
+					return err
+				}
+				cities[*b.CityID] = true
+			}
+
+			b.CreatedAt = now
+			b.UpdatedAt = now
+			if err := s.db.UpsertBoundary(ctx, b); err != nil {
+				return errx.ErrorInternal.Raise(
+					fmt.Errorf("failed to store boundary %s, cause: %w", b.ID, err),
+				)
+			}
+
+			if b.Kind == enum.BoundaryKindCity {
+				report.Cities++
+			} else {
+				report.Districts++
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return models.BoundaryImportReport{}, err
+	}
+
+	return report, nil
+}
+
+func (s Service) checkCity(ctx context.Context, cityID uuid.UUID) error {
+	city, err := s.db.GetBoundary(ctx, cityID)
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get boundary %s, cause: %w", cityID, err),
+		)
+	}
+	if city.IsNil() || city.Kind != enum.BoundaryKindCity {
+		return errx.ErrorInvalidBoundary.Raise(
+			fmt.Errorf("city %s of district is not known", cityID),
+		)
+	}
+
+	return nil
+}
+
+func validateBoundary(b models.Boundary) error {
+	if b.ID == uuid.Nil {
+		return errors.New("id is required")
+	}
+	if err := enum.CheckBoundaryKind(b.Kind); err != nil {
+		return err
+	}
+	switch {
+	case b.Kind == enum.BoundaryKindDistrict && b.CityID == nil:
+		return errors.New("district must have a city_id")
+	case b.Kind == enum.BoundaryKindCity && b.CityID != nil:
+		return errors.New("city can not have a city_id")
+	}
+	if b.CityID != nil && *b.CityID == b.ID {
+		return errors.New("district can not be its own city")
+	}
+	if utf8.RuneCountInString(b.Name) > maxNameLength {
+		return fmt.Errorf("name is longer than %d characters", maxNameLength)
+	}
+	if _, err := models.NormalizeBoundary(b.Area); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,25 @@
+package boundary
+
+import (
+	"context"
+
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+// Service keeps the local store of city and district boundaries. Places are checked against it
+// and take their city from it, see the place service.
+type Service struct {
+	db database
+}
+
+func NewService(db database) Service {
+	return Service{db: db}
+}
+
+type database interface {
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+
+	UpsertBoundary(ctx context.Context, in models.Boundary) error
+	GetBoundary(ctx context.Context, id uuid.UUID) (models.Boundary, error)
+}
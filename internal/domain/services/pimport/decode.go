@@ -0,0 +1,255 @@
+package pimport
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/chains-lab/places-svc/internal/domain/enum"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/chains-lab/places-svc/internal/domain/services/plocale"
+	"github.com/google/uuid"
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geojson"
+)
+
+const (
+	FormatCSV     = "csv"
+	FormatGeoJSON = "geojson"
+)
+
+// Decode reads the rows of an import file in the given format.
+func Decode(r io.Reader, format string) ([]Row, error) {
+	switch format {
+	case FormatCSV:
+		return DecodeCSV(r)
+	case FormatGeoJSON:
+		return DecodeGeoJSON(r)
+	default:
+		return nil, fmt.Errorf("unknown import format %q, expected %q or %q", format, FormatCSV, FormatGeoJSON)
+	}
+}
+
+// DecodeCSV reads places from a CSV file with a header row. The columns are
+// id, city_id, company_id, class, lon, lat, address, phone, website, timezone, locale, name, description
+// and timetable in OSM opening_hours syntax; name_<locale> and description_<locale> columns add other localizations.
+// Only the header is required to be well-formed, a row that can not be decoded is returned with Err set.
+func DecodeCSV(r io.Reader) ([]Row, error) {
+	cr := csv.NewReader(r)
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read csv header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"class", "lon", "lat", "locale", "name", "description"} {
+		if _, ok := columns[name]; !ok {
+			return nil, fmt.Errorf("csv header has no %q column", name)
+		}
+	}
+
+	var rows []Row
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		line, _ := cr.FieldPos(0)
+		if err != nil {
+			var parseErr *csv.ParseError
+			if !errors.As(err, &parseErr) {
+				return nil, fmt.Errorf("read csv: %w", err)
+			}
+			rows = append(rows, Row{Line: parseErr.StartLine, Err: parseErr.Err})
+			continue
+		}
+
+		get := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		row := decodeFields(get)
+		row.Line = line
+		if row.Err == nil {
+			row.Err = decodePoint(&row, get("lon"), get("lat"))
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// DecodeGeoJSON reads places from a FeatureCollection of Point features. The properties are named
+// as the CSV columns, except the point, which is the geometry; "locales" may also be a list of
+// {"locale", "name", "description"} objects.
+func DecodeGeoJSON(r io.Reader) ([]Row, error) {
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read geojson: %w", err)
+	}
+
+	fc, err := geojson.UnmarshalFeatureCollection(raw)
+	if err != nil {
+		return nil, fmt.Errorf("decode geojson feature collection: %w", err)
+	}
+
+	rows := make([]Row, 0, len(fc.Features))
+	for i, f := range fc.Features {
+		get := func(name string) string {
+			switch v := f.Properties[name].(type) {
+			case string:
+				return strings.TrimSpace(v)
+			case float64:
+				return strconv.FormatFloat(v, 'f', -1, 64)
+			default:
+				return ""
+			}
+		}
+
+		row := decodeFields(get)
+		row.Line = i + 1
+		if row.Err == nil {
+			if pt, ok := f.Geometry.(orb.Point); ok {
+				row.Place.Point = pt
+			} else {
+				row.Err = errors.New("geometry must be a Point")
+			}
+		}
+		if row.Err == nil {
+			if locales, ok := f.Properties["locales"].([]interface{}); ok {
+				row.Locales, row.Err = decodeLocaleList(locales)
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// decodeFields fills a row from the named fields shared by both formats.
+func decodeFields(get func(name string) string) Row {
+	var row Row
+
+	optional := func(name string) *string {
+		if v := get(name); v != "" {
+			return &v
+		}
+		return nil
+	}
+	parseID := func(name string) (*uuid.UUID, error) {
+		v := get(name)
+		if v == "" {
+			return nil, nil
+		}
+		id, err := uuid.Parse(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q", name, v)
+		}
+		return &id, nil
+	}
+
+	var err error
+	if row.PlaceID, err = parseID("id"); err != nil {
+		row.Err = err
+		return row
+	}
+	cityID, err := parseID("city_id")
+	if err != nil {
+		row.Err = err
+		return row
+	}
+	if cityID != nil {
+		row.Place.CityID = *cityID
+	}
+	if row.Place.DistributorID, err = parseID("company_id"); err != nil {
+		row.Err = err
+		return row
+	}
+
+	row.Place.Class = get("class")
+	row.Place.Address = get("address")
+	row.Place.Phone = optional("phone")
+	row.Place.Website = optional("website")
+	row.Place.Timezone = optional("timezone")
+	row.Place.Locale = get("locale")
+	row.Place.Name = get("name")
+	row.Place.Description = get("description")
+
+	for _, locale := range enum.GetAllLocales() {
+		if locale == row.Place.Locale {
+			continue
+		}
+		name, description := get("name_"+locale), get("description_"+locale)
+		if name != "" || description != "" {
+			row.Locales = append(row.Locales, plocale.SetParams{
+				Locale:      locale,
+				Name:        name,
+				Description: description,
+			})
+		}
+	}
+
+	if tt := get("timetable"); tt != "" {
+		timetable, err := models.ParseOpeningHours(tt)
+		if err != nil {
+			row.Err = fmt.Errorf("invalid timetable: %w", err)
+			return row
+		}
+		// "off" закрывает место на всю неделю, поэтому пустая таблица тоже заменяет текущую
+		row.Timetable = append([]models.TimeInterval{}, timetable.Table...)
+	}
+
+	return row
+}
+
+func decodePoint(row *Row, lon, lat string) error {
+	x, err := strconv.ParseFloat(lon, 64)
+	if err != nil {
+		return fmt.Errorf("invalid lon %q", lon)
+	}
+	y, err := strconv.ParseFloat(lat, 64)
+	if err != nil {
+		return fmt.Errorf("invalid lat %q", lat)
+	}
+
+	row.Place.Point = orb.Point{x, y}
+	return nil
+}
+
+func decodeLocaleList(list []interface{}) ([]plocale.SetParams, error) {
+	raw, err := json.Marshal(list)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []struct {
+		Locale      string `json:"locale"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+	if err = json.Unmarshal(raw, &items); err != nil {
+		return nil, fmt.Errorf("invalid locales: %w", err)
+	}
+
+	res := make([]plocale.SetParams, 0, len(items))
+	for _, item := range items {
+		res = append(res, plocale.SetParams{
+			Locale:      item.Locale,
+			Name:        item.Name,
+			Description: item.Description,
+		})
+	}
+	return res, nil
+}
@@ -0,0 +1,271 @@
+package pimport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/chains-lab/places-svc/internal/domain/enum"
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/chains-lab/places-svc/internal/domain/services/place"
+	"github.com/chains-lab/places-svc/internal/domain/services/plocale"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxRows limits the number of rows of a single import.
+	MaxRows = 5000
+
+	ResultCreated = "created"
+	ResultUpdated = "updated"
+	ResultFailed  = "failed"
+)
+
+// Row is a place to import: a new one, or an existing one when PlaceID is set.
+// Place.Locale, Place.Name and Place.Description are the main localization, Locales are the others.
+// A nil Timetable leaves the timetable of the place as is, otherwise it replaces the default timetable.
+type Row struct {
+	// Line is the number of the row in the file: the CSV line or the 1-based GeoJSON feature index.
+	Line int
+
+	PlaceID   *uuid.UUID
+	Place     place.CreateParams
+	Locales   []plocale.SetParams
+	Timetable []models.TimeInterval
+
+	// Err is set when the row could not be decoded, such a row is reported as failed.
+	Err error
+}
+
+type Params struct {
+	// CompanyID restricts the import to the places of the company, nil imports places of any company.
+	CompanyID *uuid.UUID
+	DryRun    bool
+}
+
+// errDryRun rolls back the transaction of a row in a dry run.
+var errDryRun = errors.New("dry run")
+
+// Import creates or updates places row by row. Each row is stored in its own transaction,
+// so a failed row is reported with the reason and does not stop the others.
+// Only an internal error aborts the import, the rows stored before it stay stored.
+func (s Service) Import(ctx context.Context, rows []Row, params Params) (models.PlaceImportReport, error) {
+	if len(rows) > MaxRows {
+		return models.PlaceImportReport{}, errx.ErrorInvalidPlacesImport.Raise(
+			fmt.Errorf("import has %d rows, at most %d are allowed", len(rows), MaxRows),
+		)
+	}
+
+	report := models.PlaceImportReport{
+		DryRun: params.DryRun,
+		Rows:   make([]models.PlaceImportRow, 0, len(rows)),
+	}
+
+	for _, row := range rows {
+		res, err := s.importRow(ctx, row, params)
+		if err != nil {
+			return models.PlaceImportReport{}, errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to import row %d, cause: %w", row.Line, err),
+			)
+		}
+
+		switch res.Result {
+		case ResultCreated:
+			report.Created++
+		case ResultUpdated:
+			report.Updated++
+		case ResultFailed:
+			report.Failed++
+		}
+		report.Rows = append(report.Rows, res)
+	}
+
+	return report, nil
+}
+
+func (s Service) importRow(ctx context.Context, row Row, params Params) (models.PlaceImportRow, error) {
+	res := models.PlaceImportRow{Row: row.Line}
+	fail := func(reason string) (models.PlaceImportRow, error) {
+		res.Result = ResultFailed
+		res.PlaceID = nil
+		res.Reason = reason
+		return res, nil
+	}
+
+	if row.Err != nil {
+		return fail(row.Err.Error())
+	}
+	if err := validateRow(row); err != nil {
+		return fail(err.Error())
+	}
+
+	if params.CompanyID != nil {
+		if row.Place.DistributorID != nil && *row.Place.DistributorID != *params.CompanyID {
+			return fail("place belongs to another company")
+		}
+		row.Place.DistributorID = params.CompanyID
+	}
+
+	err := s.db.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		if row.PlaceID == nil {
+			res.Result = ResultCreated
+			res.PlaceID, err = s.createPlace(ctx, row)
+		} else {
+			res.Result = ResultUpdated
+			res.PlaceID, err = s.updatePlace(ctx, row, params)
+		}
+		if err != nil {
+			return err
+		}
+
+		if params.DryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	switch {
+	case err == nil, errors.Is(err, errDryRun):
+		return res, nil
+	case errors.Is(err, errx.ErrorClassNotFound):
+		return fail(fmt.Sprintf("class %q not found", row.Place.Class))
+	case errors.Is(err, errx.ErrorInvalidTimezone):
+		return fail(fmt.Sprintf("invalid timezone %q", *row.Place.Timezone))
+	case errors.Is(err, errx.ErrorInvalidLocale):
+		return fail("invalid locale")
+	case errors.Is(err, errx.ErrorInvalidTimetable):
+		return fail("invalid timetable")
+	case errors.Is(err, errx.ErrorPlaceOutsideCity):
+		return fail(fmt.Sprintf("point is outside of city %s", row.Place.CityID))
+	case errors.Is(err, errx.ErrorPlaceCityNotFound):
+		return fail("no known city covers the point, set city_id")
+	case errors.Is(err, errx.ErrorPlaceNotFound):
+		return fail(fmt.Sprintf("place %s not found", row.PlaceID))
+	case errors.Is(err, errx.ErrorPlaceOfAnotherCompany):
+		return fail(fmt.Sprintf("place %s belongs to another company", row.PlaceID))
+	default:
+		return models.PlaceImportRow{}, err
+	}
+}
+
+func (s Service) createPlace(ctx context.Context, row Row) (*uuid.UUID, error) {
+	p, err := s.places.Create(ctx, row.Place)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.setDetails(ctx, p.ID, row, row.Locales); err != nil {
+		return nil, err
+	}
+
+	return &p.ID, nil
+}
+
+func (s Service) updatePlace(ctx context.Context, row Row, params Params) (*uuid.UUID, error) {
+	placeID := *row.PlaceID
+
+	current, err := s.db.GetPlaceByID(ctx, placeID, row.Place.Locale)
+	if err != nil {
+		return nil, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get place %s, cause: %w", placeID, err),
+		)
+	}
+	if current.IsNil() {
+		return nil, errx.ErrorPlaceNotFound.Raise(
+			fmt.Errorf("place %s not found", placeID),
+		)
+	}
+	if params.CompanyID != nil && (current.CompanyID == nil || *current.CompanyID != *params.CompanyID) {
+		return nil, errx.ErrorPlaceOfAnotherCompany.Raise(
+			fmt.Errorf("place %s belongs to another company", placeID),
+		)
+	}
+
+	update := place.UpdateParams{
+		Class:    &row.Place.Class,
+		Website:  row.Place.Website,
+		Phone:    row.Place.Phone,
+		Timezone: row.Place.Timezone,
+	}
+	if row.Place.Address != "" && row.Place.Address != current.Address {
+		update.Address = &row.Place.Address
+	}
+	// прежняя точка не передаётся, чтобы не сбрасывать найденный адрес; с новым адресом точка
+	// передаётся всегда, иначе обновление переместило бы место геокодированием адреса
+	if row.Place.Point != current.Point || update.Address != nil {
+		point := row.Place.Point
+		update.Point = &point
+	}
+	if _, err = s.places.Update(ctx, placeID, row.Place.Locale, update); err != nil {
+		return nil, err
+	}
+
+	main := plocale.SetParams{
+		Locale:      row.Place.Locale,
+		Name:        row.Place.Name,
+		Description: row.Place.Description,
+	}
+	if err = s.setDetails(ctx, placeID, row, append([]plocale.SetParams{main}, row.Locales...)); err != nil {
+		return nil, err
+	}
+
+	return &placeID, nil
+}
+
+func (s Service) setDetails(ctx context.Context, placeID uuid.UUID, row Row, locales []plocale.SetParams) error {
+	if err := s.locales.SetForPlace(ctx, placeID, locales...); err != nil {
+		return err
+	}
+
+	if row.Timetable != nil {
+		_, err := s.timetables.SetForPlace(ctx, placeID, row.Place.Locale, models.Timetable{Table: row.Timetable})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateRow checks a row with the rules of a place created through the API.
+func validateRow(row Row) error {
+	errs := validation.Errors{
+		"class": validation.Validate(row.Place.Class, validation.Required),
+		"locale": validation.Validate(
+			row.Place.Locale, validation.Required, validation.By(checkLocale)),
+		"name": validation.Validate(
+			row.Place.Name, validation.Required, validation.Length(1, 255)),
+		"description": validation.Validate(
+			row.Place.Description, validation.Required, validation.Length(0, 1024)),
+		"website": validation.Validate(
+			row.Place.Website, validation.Length(0, 255)),
+		"phone": validation.Validate(
+			row.Place.Phone, validation.Length(0, 32)),
+		"timezone": validation.Validate(
+			row.Place.Timezone, validation.Length(1, 64)),
+	}
+	if row.Place.Point[0] < -180 || row.Place.Point[0] > 180 || row.Place.Point[1] < -90 || row.Place.Point[1] > 90 {
+		errs["point"] = fmt.Errorf("point %v is out of range", row.Place.Point)
+	}
+
+	for i, loc := range row.Locales {
+		errs[fmt.Sprintf("locales/%d/locale", i)] = validation.Validate(
+			loc.Locale, validation.Required, validation.By(checkLocale))
+		errs[fmt.Sprintf("locales/%d/name", i)] = validation.Validate(loc.Name, validation.RuneLength(0, 128))
+		errs[fmt.Sprintf("locales/%d/description", i)] = validation.Validate(
+			loc.Description, validation.RuneLength(0, 1024))
+	}
+
+	if row.Timetable != nil {
+		errs["timetable"] = models.ValidateWeekTable(row.Timetable)
+	}
+
+	return errs.Filter()
+}
+
+func checkLocale(value interface{}) error {
+	locale, _ := value.(string)
+	return enum.CheckLocale(locale)
+}
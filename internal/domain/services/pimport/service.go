@@ -0,0 +1,47 @@
+package pimport
+
+import (
+	"context"
+
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/chains-lab/places-svc/internal/domain/services/place"
+	"github.com/chains-lab/places-svc/internal/domain/services/plocale"
+	"github.com/google/uuid"
+)
+
+// Service imports places in bulk. Every row goes through the place, locale and timetable services,
+// so imported places obey exactly the same rules as places created one by one.
+type Service struct {
+	db         database
+	places     places
+	locales    locales
+	timetables timetables
+}
+
+func NewService(db database, places places, locales locales, timetables timetables) Service {
+	return Service{
+		db:         db,
+		places:     places,
+		locales:    locales,
+		timetables: timetables,
+	}
+}
+
+type database interface {
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+
+	GetPlaceByID(ctx context.Context, placeID uuid.UUID, locale string) (models.Place, error)
+}
+
+type places interface {
+	Create(ctx context.Context, params place.CreateParams) (models.Place, error)
+	Update(ctx context.Context, placeID uuid.UUID, locale string, params place.UpdateParams) (models.Place, error)
+}
+
+type locales interface {
+	SetForPlace(ctx context.Context, placeID uuid.UUID, locales ...plocale.SetParams) error
+}
+
+type timetables interface {
+	SetForPlace(ctx context.Context, placeID uuid.UUID, locale string, intervals models.Timetable) (models.Place, error)
+}
@@ -0,0 +1,50 @@
+package place
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/google/uuid"
+	"github.com/paulmach/orb"
+)
+
+// placeCity checks the city of a place against the known city boundaries. Without a city the one covering the point
+// is taken, the smallest of them if they overlap. A city without a known boundary is trusted as it is.
+func (s Service) placeCity(ctx context.Context, cityID uuid.UUID, pt orb.Point) (uuid.UUID, error) {
+	cities, err := s.db.CityBoundariesAt(ctx, pt)
+	if err != nil {
+		return uuid.Nil, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to find cities at point %v, cause: %w", pt, err),
+		)
+	}
+
+	if cityID == uuid.Nil {
+		if len(cities) == 0 {
+			return uuid.Nil, errx.ErrorPlaceCityNotFound.Raise(
+				fmt.Errorf("no known city covers point %v", pt),
+			)
+		}
+		return cities[0].ID, nil
+	}
+
+	for _, city := range cities {
+		if city.ID == cityID {
+			return cityID, nil
+		}
+	}
+
+	city, err := s.db.GetBoundary(ctx, cityID)
+	if err != nil {
+		return uuid.Nil, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get boundary of city %s, cause: %w", cityID, err),
+		)
+	}
+	if city.IsNil() {
+		return cityID, nil
+	}
+
+	return uuid.Nil, errx.ErrorPlaceOutsideCity.Raise(
+		fmt.Errorf("point %v is outside of city %s", pt, cityID),
+	)
+}
@@ -0,0 +1,65 @@
+package place
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+)
+
+const (
+	MaxClusterZoom = 20
+
+	// ClusterCellsPerTile is the number of grid cells along a side of a 256px map tile.
+	ClusterCellsPerTile = 4
+	// ClusterSampleMax is the largest cluster that still lists the ids of its places.
+	ClusterSampleMax = 10
+	// MaxClusters limits the response to the most populated cells of the viewport.
+	MaxClusters = 1000
+)
+
+// ClusterCellSize is the side of a clustering grid cell in degrees at the given zoom.
+func ClusterCellSize(zoom uint) float64 {
+	return 360 / math.Exp2(float64(zoom)) / ClusterCellsPerTile
+}
+
+// Clusters groups the places inside the viewport into grid cells sized for the map zoom.
+// Only the class, status, verified, city, district and company filters are taken into account.
+func (s Service) Clusters(
+	ctx context.Context,
+	filter FilterParams,
+	bbox models.BBox,
+	zoom uint,
+) ([]models.PlaceCluster, error) {
+	if err := bbox.Validate(); err != nil {
+		return nil, errx.ErrorInvalidSearchArea.Raise(
+			fmt.Errorf("invalid bbox, cause: %w", err),
+		)
+	}
+	if zoom > MaxClusterZoom {
+		zoom = MaxClusterZoom
+	}
+
+	clusters, err := s.db.ClusterPlaces(ctx, FilterParams{
+		Classes:    filter.Classes,
+		Statuses:   filter.Statuses,
+		CityID:     filter.CityID,
+		DistrictID: filter.DistrictID,
+		CompanyID:  filter.CompanyID,
+		Verified:   filter.Verified,
+		BBox:       &bbox,
+	}, ClusterCellSize(zoom), ClusterSampleMax, MaxClusters)
+	if err != nil {
+		return nil, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to cluster places, cause: %w", err),
+		)
+	}
+
+	for i := range clusters {
+		clusters[i].Zoom = zoom
+	}
+
+	return clusters, nil
+}
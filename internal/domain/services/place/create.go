@@ -13,6 +13,7 @@ import (
 )
 
 type CreateParams struct {
+	// CityID may be left zero, it is then the known city whose boundary covers the point.
 	CityID        uuid.UUID
 	DistributorID *uuid.UUID
 	Class         string
@@ -20,7 +21,9 @@ type CreateParams struct {
 	Status        string
 	Phone         *string
 	Website       *string
-	Point         orb.Point
+	Timezone      *string
+	// Point may be left zero when Address is given, it is then found by geocoding the address.
+	Point orb.Point
 
 	Locale      string
 	Name        string
@@ -35,6 +38,31 @@ func (s Service) Create(
 
 	placeID := uuid.New()
 
+	// найденный по адресу кандидат уже содержит адрес, фоновая задача для него не нужна
+	var located *models.GeoCandidate
+	if params.Point == (orb.Point{}) {
+		candidate, err := s.locate(ctx, params.Address, params.Locale)
+		if err != nil {
+			return models.Place{}, err
+		}
+		located = &candidate
+		params.Point = candidate.Point
+	}
+
+	cityID, err := s.placeCity(ctx, params.CityID, params.Point)
+	if err != nil {
+		return models.Place{}, err
+	}
+	params.CityID = cityID
+
+	timezone := DefaultTimezone
+	if params.Timezone != nil {
+		if err := validateTimezone(*params.Timezone); err != nil {
+			return models.Place{}, err
+		}
+		timezone = *params.Timezone
+	}
+
 	place := models.Place{
 		ID:        placeID,
 		CityID:    params.CityID,
@@ -43,8 +71,11 @@ func (s Service) Create(
 		Verified:  false,
 		Point:     params.Point,
 		Address:   params.Address,
+		Timezone:  timezone,
 		CreatedAt: now,
 		UpdatedAt: now,
+
+		AddressStatus: enum.PlaceAddressStatusPending,
 	}
 	if params.DistributorID != nil {
 		place.CompanyID = params.DistributorID
@@ -69,7 +100,20 @@ func (s Service) Create(
 		)
 	}
 
-	var addr string
+	var addr *models.PlaceAddress
+	if located != nil {
+		addr = &located.Address
+		addr.PlaceID = placeID
+		addr.Locale = params.Locale
+		place.AddressStatus = enum.PlaceAddressStatusResolved
+
+		// адрес, введённый вручную, важнее найденного геокодером
+		if place.Address == "" {
+			place.Address = formattedAddress(*addr)
+		}
+	}
+
+	// адрес точки ищет EnrichAddresses, геокодер не держит транзакцию открытой
 	if err = s.db.Transaction(ctx, func(ctx context.Context) error {
 		err = s.db.CreatePlace(ctx, place.Details())
 		if err != nil {
@@ -91,10 +135,12 @@ func (s Service) Create(
 			)
 		}
 
-		addr, err = s.geo.Guess(ctx, params.Point)
-		if err != nil {
+		if addr == nil {
+			return nil
+		}
+		if err = s.db.UpsertPlaceAddress(ctx, *addr); err != nil {
 			return errx.ErrorInternal.Raise(
-				fmt.Errorf("could not guess address for Location, cause %w", err),
+				fmt.Errorf("could not create place address, cause %w", err),
 			)
 		}
 
@@ -112,11 +158,15 @@ func (s Service) Create(
 		Point:       params.Point,
 		CreatedAt:   now,
 		UpdatedAt:   now,
-		Address:     addr,
+		Address:     place.Address,
+		Timezone:    timezone,
 		Locale:      params.Locale,
 		Name:        params.Name,
 		Description: params.Description,
 		Timetable:   models.Timetable{},
+
+		AddressStatus:  place.AddressStatus,
+		AddressDetails: addr,
 	}
 	if params.DistributorID != nil {
 		res.CompanyID = params.DistributorID
@@ -130,3 +180,14 @@ func (s Service) Create(
 
 	return res, nil
 }
+
+// maxAddressLength is the length of places.address.
+const maxAddressLength = 255
+
+// formattedAddress is the one line address of the place, cut to fit the address column.
+func formattedAddress(addr models.PlaceAddress) string {
+	if runes := []rune(addr.Formatted); len(runes) > maxAddressLength {
+		return string(runes[:maxAddressLength])
+	}
+	return addr.Formatted
+}
@@ -0,0 +1,93 @@
+package place
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	CursorByCreatedAt = "created_at"
+	CursorByDistance  = "distance"
+	CursorByRank      = "rank"
+)
+
+// Cursor is the position of the last place of a keyset page: the sort key of that place and its id.
+// Only one of CreatedAt and Value is used, depending on the sort.
+type Cursor struct {
+	By        string    `json:"by"`
+	Asc       bool      `json:"asc"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	Value     float64   `json:"value,omitempty"`
+	ID        uuid.UUID `json:"id"`
+}
+
+// Encode makes the cursor opaque for clients.
+func (c Cursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func DecodeCursor(s string) (Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Cursor{}, fmt.Errorf("cursor is not base64url: %w", err)
+	}
+
+	var c Cursor
+	if err = json.Unmarshal(raw, &c); err != nil {
+		return Cursor{}, fmt.Errorf("malformed cursor: %w", err)
+	}
+
+	switch c.By {
+	case CursorByCreatedAt, CursorByDistance, CursorByRank:
+	default:
+		return Cursor{}, fmt.Errorf("unknown cursor sort %q", c.By)
+	}
+	if c.ID == uuid.Nil {
+		return Cursor{}, fmt.Errorf("cursor has no place id")
+	}
+
+	return c, nil
+}
+
+// CursorOrder is the single sort order of a keyset listing, ties are broken by place id in the same direction.
+type CursorOrder struct {
+	By  string
+	Asc bool
+}
+
+// cursorOrder picks the keyset order for the sort params: at most one sort is allowed,
+// without any the search results go from the most relevant and other listings from the newest.
+func cursorOrder(filter FilterParams, sort SortParams) (CursorOrder, error) {
+	var orders []CursorOrder
+	if sort.ByCreatedAt != nil {
+		orders = append(orders, CursorOrder{By: CursorByCreatedAt, Asc: *sort.ByCreatedAt})
+	}
+	if sort.ByDistance != nil {
+		if filter.Location == nil {
+			return CursorOrder{}, fmt.Errorf("sorting by distance needs a location filter")
+		}
+		orders = append(orders, CursorOrder{By: CursorByDistance, Asc: *sort.ByDistance})
+	}
+	if sort.ByRank != nil {
+		if filter.Search == nil {
+			return CursorOrder{}, fmt.Errorf("sorting by relevance needs a search query")
+		}
+		orders = append(orders, CursorOrder{By: CursorByRank, Asc: *sort.ByRank})
+	}
+
+	switch {
+	case len(orders) > 1:
+		return CursorOrder{}, fmt.Errorf("cursor pagination supports a single sort order")
+	case len(orders) == 1:
+		return orders[0], nil
+	case filter.Search != nil:
+		return CursorOrder{By: CursorByRank}, nil
+	default:
+		return CursorOrder{By: CursorByCreatedAt}, nil
+	}
+}
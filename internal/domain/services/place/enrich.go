@@ -0,0 +1,207 @@
+package place
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/chains-lab/places-svc/internal/domain/enum"
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/paulmach/orb"
+)
+
+const (
+	// AddressMaxAttempts is how many times the address of a place is looked up before it is marked failed.
+	AddressMaxAttempts = 6
+	// AddressRetryBase is the delay after the first failed lookup, it doubles with every next one
+	// up to AddressRetryMax.
+	AddressRetryBase = 30 * time.Second
+	AddressRetryMax  = time.Hour
+	// AddressLease is how long a claimed place is hidden from other workers.
+	AddressLease = 5 * time.Minute
+
+	// GeocodeCacheTTL is how long a cached address of a point is used.
+	GeocodeCacheTTL = 90 * 24 * time.Hour
+	// geocodeCacheScale rounds the cache key to 1e-4 degree, about 11 m.
+	geocodeCacheScale = 1e4
+)
+
+// EnrichReport counts the outcome of one EnrichAddresses run.
+type EnrichReport struct {
+	Claimed  int
+	Resolved int
+	Retried  int
+	Failed   int
+}
+
+// errAddressJobLost is returned when the place changed after it was claimed, the result is dropped.
+var errAddressJobLost = errors.New("place address job lost its claim")
+
+// errAddressNotFound is returned when the geocoder knows no address of the point in any locale,
+// the lookup is retried like a failed one.
+var errAddressNotFound = errors.New("no address found for the point")
+
+// EnrichAddresses looks up the addresses of up to limit pending places, in every locale the place has.
+// A failed lookup, or one that found no address, is retried later with backoff, after AddressMaxAttempts
+// the place is marked failed.
+// Geocoding runs outside of any transaction.
+func (s Service) EnrichAddresses(ctx context.Context, limit uint64) (EnrichReport, error) {
+	now := time.Now().UTC()
+
+	jobs, err := s.db.ClaimPlaceAddressJobs(ctx, now, now.Add(AddressLease), limit)
+	if err != nil {
+		return EnrichReport{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to claim place address jobs, cause: %w", err),
+		)
+	}
+
+	report := EnrichReport{Claimed: len(jobs)}
+	for _, job := range jobs {
+		addrs, guessErr := s.guessJob(ctx, job)
+		if ctx.Err() != nil {
+			// аренда истечёт, и место возьмут снова
+			return report, nil
+		}
+
+		if guessErr == nil {
+			err = s.resolveAddress(ctx, job, addrs)
+			if errors.Is(err, errAddressJobLost) {
+				continue
+			}
+			if err != nil {
+				return report, err
+			}
+			report.Resolved++
+			continue
+		}
+
+		status, retryAt := addressRetry(job.Attempts+1, time.Now().UTC())
+		if _, err = s.db.SetPlaceAddressStatus(ctx, job.PlaceID, job.Lease, status, job.Attempts+1, retryAt); err != nil {
+			return report, errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to set place address status, cause: %w", err),
+			)
+		}
+		if status == enum.PlaceAddressStatusFailed {
+			report.Failed++
+		} else {
+			report.Retried++
+		}
+	}
+
+	return report, nil
+}
+
+// addressRetry is the status and the next lookup time of a place after attempts failed lookups.
+func addressRetry(attempts int, now time.Time) (string, time.Time) {
+	if attempts >= AddressMaxAttempts {
+		return enum.PlaceAddressStatusFailed, now
+	}
+
+	delay := AddressRetryBase * time.Duration(math.Pow(2, float64(attempts-1)))
+	if delay > AddressRetryMax {
+		delay = AddressRetryMax
+	}
+	return enum.PlaceAddressStatusPending, now.Add(delay)
+}
+
+// guessJob returns the addresses found in the locales of the job, locales without one are left out.
+func (s Service) guessJob(ctx context.Context, job models.PlaceAddressJob) ([]models.PlaceAddress, error) {
+	res := make([]models.PlaceAddress, 0, len(job.Locales))
+	for _, locale := range job.Locales {
+		addr, err := s.guess(ctx, job.Point, locale)
+		if err != nil {
+			return nil, err
+		}
+		if addr.Formatted == "" {
+			continue
+		}
+		addr.PlaceID = job.PlaceID
+		addr.Locale = locale
+		res = append(res, addr)
+	}
+	if len(res) == 0 {
+		return nil, errAddressNotFound
+	}
+
+	return res, nil
+}
+
+// resolveAddress replaces the addresses of the place with the found ones, unless the place
+// was changed or claimed again since the job was claimed.
+func (s Service) resolveAddress(ctx context.Context, job models.PlaceAddressJob, addrs []models.PlaceAddress) error {
+	return s.db.Transaction(ctx, func(ctx context.Context) error {
+		ok, err := s.db.SetPlaceAddressStatus(
+			ctx, job.PlaceID, job.Lease, enum.PlaceAddressStatusResolved, 0, time.Now().UTC(),
+		)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to set place address status, cause: %w", err),
+			)
+		}
+		if !ok {
+			return errAddressJobLost
+		}
+
+		if err = s.db.DeletePlaceAddresses(ctx, job.PlaceID); err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to delete place addresses, cause: %w", err),
+			)
+		}
+		for _, addr := range addrs {
+			if err = s.db.UpsertPlaceAddress(ctx, addr); err != nil {
+				return errx.ErrorInternal.Raise(
+					fmt.Errorf("failed to set place address, cause: %w", err),
+				)
+			}
+		}
+
+		// адрес, введённый вручную, важнее найденного геокодером
+		if job.Address != "" {
+			return nil
+		}
+		if err = s.db.FillPlaceAddress(ctx, job.PlaceID, formattedAddress(addrs[0])); err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to fill place address, cause: %w", err),
+			)
+		}
+
+		return nil
+	})
+}
+
+// guess finds the address of the point through the geocode cache.
+func (s Service) guess(ctx context.Context, pt orb.Point, locale string) (models.PlaceAddress, error) {
+	lonKey, latKey := geocodeCacheKey(pt)
+	now := time.Now().UTC()
+
+	cached, err := s.db.GetGeocodeCache(ctx, lonKey, latKey, locale, now.Add(-GeocodeCacheTTL))
+	if err != nil {
+		return models.PlaceAddress{}, fmt.Errorf("get geocode cache: %w", err)
+	}
+	if cached != nil {
+		return *cached, nil
+	}
+
+	addr, err := s.geo.Guess(ctx, pt, locale)
+	if err != nil {
+		return models.PlaceAddress{}, fmt.Errorf("guess address: %w", err)
+	}
+	addr.Locale = locale
+
+	// пустой ответ не кэшируем: геокодер может быть выключен или ещё не знать точку
+	if addr.Formatted == "" {
+		return addr, nil
+	}
+	if err = s.db.PutGeocodeCache(ctx, lonKey, latKey, addr, now); err != nil {
+		return models.PlaceAddress{}, fmt.Errorf("put geocode cache: %w", err)
+	}
+
+	return addr, nil
+}
+
+func geocodeCacheKey(pt orb.Point) (lonKey, latKey int32) {
+	return int32(math.Round(pt[0] * geocodeCacheScale)), int32(math.Round(pt[1] * geocodeCacheScale))
+}
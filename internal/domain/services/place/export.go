@@ -0,0 +1,27 @@
+package place
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+)
+
+// Export passes every place matching the filter to fn, ordered by id, with all its localizations and timetable.
+// The places are read in batches from a database cursor, so the export is not limited by memory;
+// an error returned by fn stops it and is returned wrapped.
+func (s Service) Export(ctx context.Context, filter FilterParams, fn func(models.PlaceExport) error) error {
+	filter, err := validateFilterArea(filter)
+	if err != nil {
+		return err
+	}
+
+	if err = s.db.ExportPlaces(ctx, filter, fn); err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to export places, cause: %w", err),
+		)
+	}
+
+	return nil
+}
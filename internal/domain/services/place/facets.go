@@ -0,0 +1,83 @@
+package place
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+)
+
+const (
+	FacetClass    = "class"
+	FacetStatus   = "status"
+	FacetVerified = "verified"
+	FacetOpenNow  = "open_now"
+)
+
+// Facets counts the places matching the filter per value of every requested facet.
+// Each facet is counted without its own constraint, so that the counts show how other values would narrow
+// the results: the class facet ignores the class filter, open_now ignores the open_at and the time_from/time_to
+// filters and so on.
+// Class counts roll up the class tree, a place counts for its class and every ancestor of it.
+func (s Service) Facets(ctx context.Context, filter FilterParams, facets []string) (models.PlaceFacets, error) {
+	filter, err := validateFilterArea(filter)
+	if err != nil {
+		return models.PlaceFacets{}, err
+	}
+
+	var res models.PlaceFacets
+	for _, facet := range facets {
+		f := filter
+		switch facet {
+		case FacetClass:
+			f.Classes = nil
+		case FacetStatus:
+			f.Statuses = nil
+		case FacetVerified:
+			f.Verified = nil
+		case FacetOpenNow:
+			now := time.Now().UTC()
+			f.OpenAt = &now
+			f.Time = nil
+		default:
+			return models.PlaceFacets{}, errx.ErrorInvalidPlaceFacet.Raise(
+				fmt.Errorf("unknown facet %q", facet),
+			)
+		}
+
+		if facet == FacetOpenNow {
+			count, err := s.db.CountPlaces(ctx, f)
+			if err != nil {
+				return models.PlaceFacets{}, errx.ErrorInternal.Raise(
+					fmt.Errorf("failed to count open places, cause: %w", err),
+				)
+			}
+			res.OpenNow = &count
+
+			continue
+		}
+
+		counts, err := s.db.PlaceFacetCounts(ctx, f, facet)
+		if err != nil {
+			return models.PlaceFacets{}, errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to count places by %s, cause: %w", facet, err),
+			)
+		}
+		if counts == nil {
+			counts = []models.PlaceFacetCount{}
+		}
+
+		switch facet {
+		case FacetClass:
+			res.Class = counts
+		case FacetStatus:
+			res.Status = counts
+		case FacetVerified:
+			res.Verified = counts
+		}
+	}
+
+	return res, nil
+}
@@ -3,6 +3,7 @@ package place
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/chains-lab/places-svc/internal/domain/errx"
 	"github.com/chains-lab/places-svc/internal/domain/models"
@@ -19,8 +20,24 @@ type FilterParams struct {
 	Name      *string
 	Address   *string
 
+	// DistrictID keeps places inside the boundary of the district, see the boundary service.
+	DistrictID *uuid.UUID
+
+	// CountryCode, Postcode and Street match the structured address in any locale.
+	CountryCode *string
+	Postcode    *string
+	Street      *string
+
 	Time     *models.TimeInterval
 	Location *FilterDistance
+	Search   *FilterSearch
+
+	// BBox keeps places inside the viewport, Area keeps places inside a drawn polygon or multipolygon.
+	BBox *models.BBox
+	Area orb.Geometry
+
+	// OpenAt keeps places open at this instant in their own local time.
+	OpenAt *time.Time
 }
 
 type FilterDistance struct {
@@ -28,9 +45,36 @@ type FilterDistance struct {
 	RadiusM uint64
 }
 
+const (
+	DefaultCursorSize = 10
+	MaxCursorSize     = 100
+)
+
+// FilterSearch is a full-text query over place names and descriptions in all locales.
+type FilterSearch struct {
+	Query string
+	// Highlight asks for a snippet with the matched words marked.
+	Highlight bool
+}
+
 type SortParams struct {
 	ByCreatedAt *bool
 	ByDistance  *bool
+	ByRank      *bool
+}
+
+// PageParams selects a page by its number, SkipTotal skips counting all the matching places.
+type PageParams struct {
+	Page      uint64
+	Size      uint64
+	SkipTotal bool
+}
+
+// CursorParams selects a keyset page after the cursor, an empty cursor is the first page.
+type CursorParams struct {
+	Cursor    string
+	Size      uint64
+	SkipTotal bool
 }
 
 func (s Service) Filter(
@@ -40,7 +84,28 @@ func (s Service) Filter(
 	sort SortParams,
 	page, size uint64,
 ) (models.PlacesCollection, error) {
-	rows, err := s.db.FilterPlaces(ctx, locale, filter, sort, page, size)
+	return s.FilterPage(ctx, locale, filter, sort, PageParams{Page: page, Size: size})
+}
+
+func (s Service) FilterPage(
+	ctx context.Context,
+	locale string,
+	filter FilterParams,
+	sort SortParams,
+	page PageParams,
+) (models.PlacesCollection, error) {
+	filter, err := validateFilterArea(filter)
+	if err != nil {
+		return models.PlacesCollection{}, err
+	}
+
+	// search results without an explicit order go from the most relevant
+	if filter.Search != nil && sort.ByCreatedAt == nil && sort.ByDistance == nil && sort.ByRank == nil {
+		desc := false
+		sort.ByRank = &desc
+	}
+
+	rows, err := s.db.FilterPlaces(ctx, locale, filter, sort, page)
 	if err != nil {
 		return models.PlacesCollection{}, errx.ErrorInternal.Raise(
 			fmt.Errorf("failed to list places, cause: %w", err),
@@ -49,3 +114,79 @@ func (s Service) Filter(
 
 	return rows, nil
 }
+
+// FilterByCursor lists places with keyset pagination, so pages do not shift while new places appear.
+// The cursor is bound to the sort order it was issued for.
+func (s Service) FilterByCursor(
+	ctx context.Context,
+	locale string,
+	filter FilterParams,
+	sort SortParams,
+	params CursorParams,
+) (models.PlacesCollection, error) {
+	filter, err := validateFilterArea(filter)
+	if err != nil {
+		return models.PlacesCollection{}, err
+	}
+
+	order, err := cursorOrder(filter, sort)
+	if err != nil {
+		return models.PlacesCollection{}, errx.ErrorInvalidPlacesCursor.Raise(
+			fmt.Errorf("invalid cursor order, cause: %w", err),
+		)
+	}
+
+	var after *Cursor
+	if params.Cursor != "" {
+		c, err := DecodeCursor(params.Cursor)
+		if err != nil {
+			return models.PlacesCollection{}, errx.ErrorInvalidPlacesCursor.Raise(
+				fmt.Errorf("invalid cursor, cause: %w", err),
+			)
+		}
+		if c.By != order.By || c.Asc != order.Asc {
+			return models.PlacesCollection{}, errx.ErrorInvalidPlacesCursor.Raise(
+				fmt.Errorf("cursor was issued for another sort order"),
+			)
+		}
+		after = &c
+	}
+
+	if params.Size == 0 {
+		params.Size = DefaultCursorSize
+	}
+	if params.Size > MaxCursorSize {
+		params.Size = MaxCursorSize
+	}
+
+	rows, err := s.db.FilterPlacesByCursor(ctx, locale, filter, order, after, params.Size, params.SkipTotal)
+	if err != nil {
+		return models.PlacesCollection{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to list places by cursor, cause: %w", err),
+		)
+	}
+
+	return rows, nil
+}
+
+// validateFilterArea checks the bbox and the area of the filter, the area comes back normalized.
+func validateFilterArea(filter FilterParams) (FilterParams, error) {
+	if filter.BBox != nil {
+		if err := filter.BBox.Validate(); err != nil {
+			return FilterParams{}, errx.ErrorInvalidSearchArea.Raise(
+				fmt.Errorf("invalid bbox, cause: %w", err),
+			)
+		}
+	}
+	if filter.Area != nil {
+		area, err := models.NormalizeArea(filter.Area)
+		if err != nil {
+			return FilterParams{}, errx.ErrorInvalidSearchArea.Raise(
+				fmt.Errorf("invalid area, cause: %w", err),
+			)
+		}
+		filter.Area = area
+	}
+
+	return filter, nil
+}
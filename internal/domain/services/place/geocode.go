@@ -0,0 +1,70 @@
+package place
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+)
+
+// SameCandidateDistanceM is how close geocoding candidates must be to count as the same place,
+// e.g. a building and its entrance found for one address.
+const SameCandidateDistanceM = 100
+
+// locate finds the single point of the address. An address matching several distinct points
+// fails with ErrorAddressAmbiguous caused by a *models.AmbiguousAddress with the candidates.
+func (s Service) locate(ctx context.Context, address, locale string) (models.GeoCandidate, error) {
+	if address == "" {
+		return models.GeoCandidate{}, errx.ErrorAddressNotFound.Raise(
+			errors.New("either a point or an address is required"),
+		)
+	}
+
+	found, err := s.geo.Locate(ctx, address, locale)
+	if err != nil {
+		return models.GeoCandidate{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("could not locate address %q, cause %w", address, err),
+		)
+	}
+
+	candidates := distinctCandidates(found)
+	switch len(candidates) {
+	case 0:
+		return models.GeoCandidate{}, errx.ErrorAddressNotFound.Raise(
+			fmt.Errorf("address %q not found", address),
+		)
+	case 1:
+		candidate := candidates[0]
+		candidate.Address.Locale = locale
+		return candidate, nil
+	default:
+		return models.GeoCandidate{}, errx.ErrorAddressAmbiguous.Raise(&models.AmbiguousAddress{
+			Address:    address,
+			Candidates: candidates,
+		})
+	}
+}
+
+// distinctCandidates drops the candidates close to a better one, the best come first.
+func distinctCandidates(candidates []models.GeoCandidate) []models.GeoCandidate {
+	res := make([]models.GeoCandidate, 0, len(candidates))
+	for _, c := range candidates {
+		if !nearAny(c.Point, res) {
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+func nearAny(pt orb.Point, candidates []models.GeoCandidate) bool {
+	for _, c := range candidates {
+		if geo.Distance(pt, c.Point) <= SameCandidateDistanceM {
+			return true
+		}
+	}
+	return false
+}
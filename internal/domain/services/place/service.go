@@ -32,14 +32,53 @@ type database interface {
 	UpdateVerifiedPlace(ctx context.Context, placeID uuid.UUID, verified bool, updatedAt time.Time) error
 	UpdatePlaceStatus(ctx context.Context, placeID uuid.UUID, status string, updatedAt time.Time) error
 
-	FilterPlaces(ctx context.Context, locale string, filter FilterParams, sort SortParams, page, size uint64) (models.PlacesCollection, error)
+	FilterPlaces(ctx context.Context, locale string, filter FilterParams, sort SortParams, page PageParams) (models.PlacesCollection, error)
+	FilterPlacesByCursor(
+		ctx context.Context,
+		locale string,
+		filter FilterParams,
+		order CursorOrder,
+		after *Cursor,
+		size uint64,
+		skipTotal bool,
+	) (models.PlacesCollection, error)
 	GetPlaceByID(ctx context.Context, placeID uuid.UUID, locale string) (models.Place, error)
+	SuggestPlaces(ctx context.Context, locale string, params SuggestParams) ([]models.PlaceSuggestion, error)
+	PlacesTile(ctx context.Context, locale string, filter FilterParams, z, x, y uint32) ([]byte, error)
+	ClusterPlaces(ctx context.Context, filter FilterParams, cell float64, sampleMax, limit uint64) ([]models.PlaceCluster, error)
+	CountPlaces(ctx context.Context, filter FilterParams) (uint64, error)
+	PlaceFacetCounts(ctx context.Context, filter FilterParams, facet string) ([]models.PlaceFacetCount, error)
+	ExportPlaces(ctx context.Context, filter FilterParams, fn func(models.PlaceExport) error) error
 
 	DeletePlace(ctx context.Context, placeID uuid.UUID) error
 
 	CreatePlaceLocale(ctx context.Context, input models.PlaceLocale) error
+
+	UpsertPlaceAddress(ctx context.Context, addr models.PlaceAddress) error
+	GetPlaceAddresses(ctx context.Context, placeID uuid.UUID) ([]models.PlaceAddress, error)
+	DeletePlaceAddresses(ctx context.Context, placeID uuid.UUID) error
+
+	ClaimPlaceAddressJobs(ctx context.Context, now, lease time.Time, limit uint64) ([]models.PlaceAddressJob, error)
+	SetPlaceAddressStatus(
+		ctx context.Context,
+		placeID uuid.UUID,
+		lease time.Time,
+		status string,
+		attempts int,
+		retryAt time.Time,
+	) (bool, error)
+	FillPlaceAddress(ctx context.Context, placeID uuid.UUID, address string) error
+
+	GetGeocodeCache(ctx context.Context, lonKey, latKey int32, locale string, since time.Time) (*models.PlaceAddress, error)
+	PutGeocodeCache(ctx context.Context, lonKey, latKey int32, addr models.PlaceAddress, at time.Time) error
+
+	GetBoundary(ctx context.Context, id uuid.UUID) (models.Boundary, error)
+	CityBoundariesAt(ctx context.Context, pt orb.Point) ([]models.Boundary, error)
 }
 
+// GeoGuesser finds the structured address of a point and the points of an address,
+// localized where the provider can. Locate returns the best match first.
 type GeoGuesser interface {
-	Guess(ctx context.Context, pt orb.Point) (string, error)
+	Guess(ctx context.Context, pt orb.Point, locale string) (models.PlaceAddress, error)
+	Locate(ctx context.Context, address, locale string) ([]models.GeoCandidate, error)
 }
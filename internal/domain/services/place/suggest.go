@@ -0,0 +1,46 @@
+package place
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chains-lab/places-svc/internal/domain/enum"
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/paulmach/orb"
+)
+
+const (
+	DefaultSuggestLimit = 10
+	MaxSuggestLimit     = 20
+)
+
+type SuggestParams struct {
+	Query string
+	// Point boosts places near it, optional.
+	Point *orb.Point
+	Limit uint64
+}
+
+// Suggest returns active places whose name in any locale starts with or resembles the query.
+func (s Service) Suggest(ctx context.Context, locale string, params SuggestParams) ([]models.PlaceSuggestion, error) {
+	if err := enum.CheckLocale(locale); err != nil {
+		locale = enum.LocaleEN
+	}
+
+	if params.Limit == 0 {
+		params.Limit = DefaultSuggestLimit
+	}
+	if params.Limit > MaxSuggestLimit {
+		params.Limit = MaxSuggestLimit
+	}
+
+	res, err := s.db.SuggestPlaces(ctx, locale, params)
+	if err != nil {
+		return nil, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to suggest places, cause: %w", err),
+		)
+	}
+
+	return res, nil
+}
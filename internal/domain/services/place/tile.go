@@ -0,0 +1,38 @@
+package place
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chains-lab/places-svc/internal/domain/enum"
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+)
+
+const MaxTileZoom = 22
+
+// Tile renders the places matching the filter inside the web mercator tile z/x/y as a Mapbox Vector Tile.
+func (s Service) Tile(ctx context.Context, locale string, filter FilterParams, z, x, y uint32) ([]byte, error) {
+	if z > MaxTileZoom || x >= 1<<z || y >= 1<<z {
+		return nil, errx.ErrorInvalidTile.Raise(
+			fmt.Errorf("tile %d/%d/%d is out of range", z, x, y),
+		)
+	}
+
+	if err := enum.CheckLocale(locale); err != nil {
+		locale = enum.LocaleEN
+	}
+
+	filter, err := validateFilterArea(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	tile, err := s.db.PlacesTile(ctx, locale, filter, z, x, y)
+	if err != nil {
+		return nil, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to render places tile %d/%d/%d, cause: %w", z, x, y, err),
+		)
+	}
+
+	return tile, nil
+}
@@ -0,0 +1,28 @@
+package place
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+)
+
+// DefaultTimezone is used for places created without an explicit time zone.
+const DefaultTimezone = "UTC"
+
+func validateTimezone(name string) error {
+	// time.LoadLocation treats "" and "Local" specially, neither is an IANA name
+	if name == "" || name == "Local" {
+		return errx.ErrorInvalidTimezone.Raise(
+			fmt.Errorf("timezone %q is not a valid IANA time zone", name),
+		)
+	}
+
+	if _, err := time.LoadLocation(name); err != nil {
+		return errx.ErrorInvalidTimezone.Raise(
+			fmt.Errorf("timezone %q is not a valid IANA time zone, cause: %w", name, err),
+		)
+	}
+
+	return nil
+}
@@ -13,11 +13,14 @@ import (
 )
 
 type UpdateParams struct {
-	Class   *string
+	Class *string
+	// Point is found by geocoding the Address when only the address is given.
 	Point   *orb.Point
 	Website *string
 	Phone   *string
 	Address *string
+
+	Timezone *string
 }
 
 func (s Service) Update(
@@ -47,7 +50,19 @@ func (s Service) Update(
 
 		place.Class = *params.Class
 	}
+	var located *models.GeoCandidate
+	if params.Point == nil && params.Address != nil && *params.Address != "" {
+		candidate, err := s.locate(ctx, *params.Address, place.Locale)
+		if err != nil {
+			return models.Place{}, err
+		}
+		located = &candidate
+		params.Point = &candidate.Point
+	}
 	if params.Point != nil {
+		if _, err = s.placeCity(ctx, place.CityID, *params.Point); err != nil {
+			return models.Place{}, err
+		}
 		place.Point = *params.Point
 	}
 	if params.Website != nil {
@@ -67,13 +82,65 @@ func (s Service) Update(
 	if params.Address != nil {
 		place.Address = *params.Address
 	}
+	if params.Timezone != nil {
+		if err = validateTimezone(*params.Timezone); err != nil {
+			return models.Place{}, err
+		}
+		place.Timezone = *params.Timezone
+	}
 	place.UpdatedAt = time.Now().UTC()
 
-	err = s.db.UpdatePlace(ctx, placeID, params, place.UpdatedAt)
+	// адрес новой точки найдёт EnrichAddresses, если его не нашло прямое геокодирование, как в Create
+	var addr *models.PlaceAddress
+	switch {
+	case located != nil:
+		addr = &located.Address
+		addr.PlaceID = placeID
+		addr.Locale = place.Locale
+		place.AddressStatus = enum.PlaceAddressStatusResolved
+		place.AddressDetails = addr
+	case params.Point != nil:
+		place.AddressStatus = enum.PlaceAddressStatusPending
+		place.AddressDetails = nil
+	}
+
+	err = s.db.Transaction(ctx, func(ctx context.Context) error {
+		err = s.db.UpdatePlace(ctx, placeID, params, place.UpdatedAt)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to update place, cause: %w", err),
+			)
+		}
+
+		if params.Point == nil {
+			return nil
+		}
+
+		// адрес старой точки больше не верен ни в одной локали
+		if err = s.db.DeletePlaceAddresses(ctx, placeID); err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to delete place addresses, cause: %w", err),
+			)
+		}
+		if addr != nil {
+			if err = s.db.UpsertPlaceAddress(ctx, *addr); err != nil {
+				return errx.ErrorInternal.Raise(
+					fmt.Errorf("failed to update place address, cause: %w", err),
+				)
+			}
+		}
+		if _, err = s.db.SetPlaceAddressStatus(
+			ctx, placeID, time.Time{}, place.AddressStatus, 0, place.UpdatedAt,
+		); err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to reset place address status, cause: %w", err),
+			)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return models.Place{}, errx.ErrorInternal.Raise(
-			fmt.Errorf("failed to update place, cause: %w", err),
-		)
+		return models.Place{}, err
 	}
 
 	return place, nil
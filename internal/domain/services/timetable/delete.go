@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 )
 
+// DeleteForPlace removes all weekly timetables of the place.
 func (s Service) DeleteForPlace(ctx context.Context, placeID uuid.UUID) error {
 	exist, err := s.db.PlaceExists(ctx, placeID)
 	if err != nil {
@@ -30,3 +31,47 @@ func (s Service) DeleteForPlace(ctx context.Context, placeID uuid.UUID) error {
 
 	return nil
 }
+
+// DeleteNamedForPlace removes one weekly timetable of the place, the others stay.
+func (s Service) DeleteNamedForPlace(ctx context.Context, placeID uuid.UUID, name string) error {
+	timetables, err := s.db.GetTimetablesByPlaceID(ctx, placeID)
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("could not list timetable, cause: %w", err),
+		)
+	}
+
+	found := false
+	for _, tt := range timetables {
+		if tt.Name == name {
+			found = true
+			break
+		}
+	}
+	if !found {
+		exist, err := s.db.PlaceExists(ctx, placeID)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to check existence of place %s, cause: %w", placeID, err),
+			)
+		}
+		if !exist {
+			return errx.ErrorPlaceNotFound.Raise(
+				fmt.Errorf("place %s not found", placeID),
+			)
+		}
+
+		return errx.ErrorTimetableNotFound.Raise(
+			fmt.Errorf("timetable %q of place %s not found", name, placeID),
+		)
+	}
+
+	err = s.db.DeleteTimetableByName(ctx, placeID, name)
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("could not delete timetable, cause: %w", err),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package timetable
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+// DeleteDayForPlace removes the intervals that start on the weekday from the named weekly timetable
+// of the place (the default one when the name is empty), so the place is closed that day.
+func (s Service) DeleteDayForPlace(ctx context.Context, placeID uuid.UUID, name string, weekday time.Weekday) error {
+	if name == "" {
+		name = models.DefaultTimetableName
+	}
+
+	exist, err := s.db.PlaceExists(ctx, placeID)
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to check existence of place %s, cause: %w", placeID, err),
+		)
+	}
+	if !exist {
+		return errx.ErrorPlaceNotFound.Raise(
+			fmt.Errorf("place %s not found", placeID),
+		)
+	}
+
+	return s.db.Transaction(ctx, func(ctx context.Context) error {
+		tt, err := s.db.GetTimetableForUpdate(ctx, placeID, name)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("could not get timetable, cause: %w", err),
+			)
+		}
+		if tt.Name == "" {
+			return errx.ErrorTimetableNotFound.Raise(
+				fmt.Errorf("timetable %q of place %s not found", name, placeID),
+			)
+		}
+
+		tt.Table = withoutDay(tt.Table, weekday)
+		tt.TemplateID = nil
+
+		err = s.db.SetTimetable(ctx, placeID, tt, time.Now().UTC())
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("could not upsert timetable, cause: %w", err),
+			)
+		}
+
+		return nil
+	})
+}
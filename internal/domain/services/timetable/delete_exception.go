@@ -0,0 +1,35 @@
+package timetable
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/google/uuid"
+)
+
+func (s Service) DeleteExceptionForPlace(ctx context.Context, placeID uuid.UUID, date time.Time) error {
+	date = dateOf(date)
+
+	exist, err := s.db.TimetableExceptionExists(ctx, placeID, date)
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to check timetable exception for place %s, cause: %w", placeID, err),
+		)
+	}
+	if !exist {
+		return errx.ErrorTimetableExceptionNotFound.Raise(
+			fmt.Errorf("place %s has no timetable exception for %s", placeID, date.Format(time.DateOnly)),
+		)
+	}
+
+	err = s.db.DeleteTimetableException(ctx, placeID, date)
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("could not delete timetable exception, cause: %w", err),
+		)
+	}
+
+	return nil
+}
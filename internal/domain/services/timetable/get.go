@@ -3,19 +3,92 @@ package timetable
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/chains-lab/places-svc/internal/domain/errx"
 	"github.com/chains-lab/places-svc/internal/domain/models"
 	"github.com/google/uuid"
 )
 
+// GetForPlace returns the weekly timetable of the place that is in force today in the place time zone,
+// together with its upcoming date exceptions. A place without a timetable for today gets an empty table.
 func (s Service) GetForPlace(ctx context.Context, placeID uuid.UUID) (models.Timetable, error) {
-	rows, err := s.db.GetTimetableByPlaceID(ctx, placeID)
+	place, err := s.db.GetPlaceByID(ctx, placeID, "")
+	if err != nil {
+		return models.Timetable{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get place %s, cause: %w", placeID, err),
+		)
+	}
+	if place.IsNil() {
+		return models.Timetable{}, errx.ErrorPlaceNotFound.Raise(
+			fmt.Errorf("place %s not found", placeID),
+		)
+	}
+
+	timetables, err := s.db.GetTimetablesByPlaceID(ctx, placeID)
 	if err != nil {
 		return models.Timetable{}, errx.ErrorInternal.Raise(
 			fmt.Errorf("could not list timetable, cause: %w", err),
 		)
 	}
 
-	return rows, nil
+	now := placeNow(place.Timezone)
+	res, _ := models.ActiveTimetable(timetables, now)
+
+	return s.withExceptions(ctx, placeID, res, now)
+}
+
+// GetNamedForPlace returns the weekly timetable of the place with the given name, whether it is in force or not.
+func (s Service) GetNamedForPlace(ctx context.Context, placeID uuid.UUID, name string) (models.Timetable, error) {
+	place, err := s.db.GetPlaceByID(ctx, placeID, "")
+	if err != nil {
+		return models.Timetable{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get place %s, cause: %w", placeID, err),
+		)
+	}
+	if place.IsNil() {
+		return models.Timetable{}, errx.ErrorPlaceNotFound.Raise(
+			fmt.Errorf("place %s not found", placeID),
+		)
+	}
+
+	timetables, err := s.db.GetTimetablesByPlaceID(ctx, placeID)
+	if err != nil {
+		return models.Timetable{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("could not list timetable, cause: %w", err),
+		)
+	}
+
+	for _, tt := range timetables {
+		if tt.Name == name {
+			return s.withExceptions(ctx, placeID, tt, placeNow(place.Timezone))
+		}
+	}
+
+	return models.Timetable{}, errx.ErrorTimetableNotFound.Raise(
+		fmt.Errorf("timetable %q of place %s not found", name, placeID),
+	)
+}
+
+// withExceptions attaches the exceptions from the date of now on, now is in the place time zone.
+func (s Service) withExceptions(ctx context.Context, placeID uuid.UUID, tt models.Timetable, now time.Time) (models.Timetable, error) {
+	today := dateOf(now)
+	exceptions, err := s.db.GetTimetableExceptions(ctx, placeID, &today, nil)
+	if err != nil {
+		return models.Timetable{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("could not list timetable exceptions, cause: %w", err),
+		)
+	}
+	tt.Exceptions = exceptions
+
+	return tt, nil
+}
+
+// placeNow is the current time in the place time zone, in UTC if the zone is unknown.
+func placeNow(timezone string) time.Time {
+	now := time.Now().UTC()
+	if loc, err := time.LoadLocation(timezone); err == nil {
+		now = now.In(loc)
+	}
+	return now
 }
@@ -0,0 +1,52 @@
+package timetable
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type ExceptionsFilter struct {
+	From *time.Time
+	To   *time.Time
+}
+
+func (s Service) GetExceptionsForPlace(
+	ctx context.Context,
+	placeID uuid.UUID,
+	filter ExceptionsFilter,
+) ([]models.TimetableException, error) {
+	exist, err := s.db.PlaceExists(ctx, placeID)
+	if err != nil {
+		return nil, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to check existence of place %s, cause: %w", placeID, err),
+		)
+	}
+	if !exist {
+		return nil, errx.ErrorPlaceNotFound.Raise(
+			fmt.Errorf("place %s not found", placeID),
+		)
+	}
+
+	if filter.From != nil {
+		from := dateOf(*filter.From)
+		filter.From = &from
+	}
+	if filter.To != nil {
+		to := dateOf(*filter.To)
+		filter.To = &to
+	}
+
+	exceptions, err := s.db.GetTimetableExceptions(ctx, placeID, filter.From, filter.To)
+	if err != nil {
+		return nil, errx.ErrorInternal.Raise(
+			fmt.Errorf("could not list timetable exceptions, cause: %w", err),
+		)
+	}
+
+	return exceptions, nil
+}
@@ -2,6 +2,7 @@ package timetable
 
 import (
 	"context"
+	"time"
 
 	"github.com/chains-lab/places-svc/internal/domain/models"
 	"github.com/google/uuid"
@@ -22,7 +23,17 @@ type database interface {
 
 	GetPlaceByID(ctx context.Context, placeID uuid.UUID, locale string) (models.Place, error)
 
-	SetTimetable(ctx context.Context, placeID uuid.UUID, intervals models.Timetable) error
-	GetTimetableByPlaceID(ctx context.Context, placeID uuid.UUID) (models.Timetable, error)
+	SetTimetable(ctx context.Context, placeID uuid.UUID, intervals models.Timetable, updatedAt time.Time) error
+	GetTimetablesByPlaceID(ctx context.Context, placeID uuid.UUID) ([]models.Timetable, error)
+	GetTimetableForUpdate(ctx context.Context, placeID uuid.UUID, name string) (models.Timetable, error)
 	DeleteTimetableByPlaceID(ctx context.Context, placeID uuid.UUID) error
+	DeleteTimetableByName(ctx context.Context, placeID uuid.UUID, name string) error
+
+	GetTimetableTemplate(ctx context.Context, templateID uuid.UUID) (models.TimetableTemplate, error)
+	DetachTimetableTemplate(ctx context.Context, placeID uuid.UUID, name string, updatedAt time.Time) error
+
+	SetTimetableException(ctx context.Context, placeID uuid.UUID, exception models.TimetableException) error
+	GetTimetableExceptions(ctx context.Context, placeID uuid.UUID, from, to *time.Time) ([]models.TimetableException, error)
+	TimetableExceptionExists(ctx context.Context, placeID uuid.UUID, date time.Time) (bool, error)
+	DeleteTimetableException(ctx context.Context, placeID uuid.UUID, date time.Time) error
 }
@@ -3,12 +3,17 @@ package timetable
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/chains-lab/places-svc/internal/domain/errx"
 	"github.com/chains-lab/places-svc/internal/domain/models"
 	"github.com/google/uuid"
 )
 
+// SetForPlace creates or replaces the weekly timetable intervals.Name of the place (the default one when
+// the name is empty) together with its validity dates. Other named timetables of the place are kept.
+// A timetable linked to a template is detached from it and becomes a local override.
 func (s Service) SetForPlace(
 	ctx context.Context,
 	placeID uuid.UUID,
@@ -28,15 +33,50 @@ func (s Service) SetForPlace(
 		)
 	}
 
-	if err = s.db.Transaction(ctx, func(ctx context.Context) error {
-		err = s.db.DeleteTimetableByPlaceID(ctx, placeID)
-		if err != nil {
-			return errx.ErrorInternal.Raise(
-				fmt.Errorf("could not upsert timetable, cause: %w", err),
-			)
-		}
+	// локальное расписание отвязывает место от шаблона
+	intervals.TemplateID = nil
+
+	return s.setForPlace(ctx, place, intervals)
+}
 
-		err = s.db.SetTimetable(ctx, placeID, intervals)
+func (s Service) setForPlace(ctx context.Context, place models.Place, intervals models.Timetable) (models.Place, error) {
+	placeID := place.ID
+
+	if intervals.Name == "" {
+		intervals.Name = models.DefaultTimetableName
+	}
+	if intervals.ValidFrom != nil {
+		from := dateOf(*intervals.ValidFrom)
+		intervals.ValidFrom = &from
+	}
+	if intervals.ValidTo != nil {
+		to := dateOf(*intervals.ValidTo)
+		intervals.ValidTo = &to
+	}
+
+	if err := models.ValidateWeekTable(intervals.Table); err != nil {
+		return models.Place{}, errx.ErrorInvalidTimetable.Raise(err)
+	}
+
+	existing, err := s.db.GetTimetablesByPlaceID(ctx, placeID)
+	if err != nil {
+		return models.Place{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("could not list timetables, cause: %w", err),
+		)
+	}
+	if err = validateValidity(intervals, existing); err != nil {
+		return models.Place{}, errx.ErrorInvalidTimetable.Raise(err)
+	}
+
+	table := make([]models.TimeInterval, len(intervals.Table))
+	copy(table, intervals.Table)
+	sort.Slice(table, func(i, j int) bool {
+		return table[i].From.ToNumberMinutes() < table[j].From.ToNumberMinutes()
+	})
+	intervals.Table = table
+
+	if err = s.db.Transaction(ctx, func(ctx context.Context) error {
+		err = s.db.SetTimetable(ctx, placeID, intervals, time.Now().UTC())
 		if err != nil {
 			return errx.ErrorInternal.Raise(
 				fmt.Errorf("could not upsert timetable, cause: %w", err),
@@ -52,3 +92,23 @@ func (s Service) SetForPlace(
 
 	return place, nil
 }
+
+// validateValidity checks the validity dates of tt and that no other timetable of the place has exactly
+// the same dates, otherwise one of them could never become active.
+func validateValidity(tt models.Timetable, existing []models.Timetable) error {
+	if tt.ValidFrom != nil && tt.ValidTo != nil && tt.ValidTo.Before(*tt.ValidFrom) {
+		return fmt.Errorf("valid_to %s is before valid_from %s",
+			tt.ValidTo.Format(time.DateOnly), tt.ValidFrom.Format(time.DateOnly))
+	}
+
+	sameDate := func(a, b *time.Time) bool {
+		return (a == nil && b == nil) || (a != nil && b != nil && a.Equal(*b))
+	}
+	for _, other := range existing {
+		if other.Name != tt.Name && sameDate(other.ValidFrom, tt.ValidFrom) && sameDate(other.ValidTo, tt.ValidTo) {
+			return fmt.Errorf("timetable %q is already valid for the same dates", other.Name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package timetable
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type DayParams struct {
+	Name    string
+	Weekday time.Weekday
+	// Intervals start on Weekday, an interval whose To is before From ends on the next day.
+	Intervals []models.DayInterval
+}
+
+// SetDayForPlace replaces the intervals that start on one weekday in the named weekly timetable of the place
+// (the default one when the name is empty), the other days are kept. The timetable is locked for the
+// duration of the change, so concurrent edits of different days do not overwrite each other.
+func (s Service) SetDayForPlace(
+	ctx context.Context,
+	placeID uuid.UUID,
+	locale string,
+	params DayParams,
+) (models.Place, error) {
+	place, err := s.db.GetPlaceByID(ctx, placeID, locale)
+	if err != nil {
+		return models.Place{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get place %s, cause: %w", placeID, err),
+		)
+	}
+	if place.IsNil() {
+		return models.Place{}, errx.ErrorPlaceNotFound.Raise(
+			fmt.Errorf("place %s not found", placeID),
+		)
+	}
+
+	if params.Name == "" {
+		params.Name = models.DefaultTimetableName
+	}
+
+	day := make([]models.TimeInterval, 0, len(params.Intervals))
+	for i, interval := range params.Intervals {
+		switch {
+		case interval.From < 0 || interval.From >= 24*time.Hour || interval.To <= 0 || interval.To > 24*time.Hour:
+			return models.Place{}, errx.ErrorInvalidTimetable.Raise(
+				fmt.Errorf("interval %d: %s-%s is out of the day", i, interval.From, interval.To),
+			)
+		case interval.From == interval.To:
+			return models.Place{}, errx.ErrorInvalidTimetable.Raise(
+				fmt.Errorf("interval %d is empty", i),
+			)
+		}
+
+		start := int(params.Weekday)*24*60 + int(interval.From/time.Minute)
+		end := int(params.Weekday)*24*60 + int(interval.To/time.Minute)
+		if interval.To < interval.From {
+			end += 24 * 60
+		}
+
+		day = append(day, models.TimeInterval{
+			From: models.NumberMinutesToMoment(start),
+			To:   models.NumberMinutesToMoment(end),
+		})
+	}
+
+	var tt models.Timetable
+	if err = s.db.Transaction(ctx, func(ctx context.Context) error {
+		tt, err = s.db.GetTimetableForUpdate(ctx, placeID, params.Name)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("could not get timetable, cause: %w", err),
+			)
+		}
+		if tt.Name == "" {
+			tt = models.Timetable{Name: params.Name}
+		}
+
+		tt.Table = append(withoutDay(tt.Table, params.Weekday), day...)
+		sort.Slice(tt.Table, func(i, j int) bool {
+			return tt.Table[i].From.ToNumberMinutes() < tt.Table[j].From.ToNumberMinutes()
+		})
+		// правка дня — локальное изменение, место отвязывается от шаблона
+		tt.TemplateID = nil
+
+		// пересечения с соседними днями (в т.ч. ночными интервалами) ловит общая проверка недели
+		if err = models.ValidateWeekTable(tt.Table); err != nil {
+			return errx.ErrorInvalidTimetable.Raise(err)
+		}
+
+		err = s.db.SetTimetable(ctx, placeID, tt, time.Now().UTC())
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("could not upsert timetable, cause: %w", err),
+			)
+		}
+
+		return nil
+	}); err != nil {
+		return models.Place{}, err
+	}
+
+	place.Timetable = tt
+
+	return place, nil
+}
+
+// withoutDay returns the intervals that do not start on the weekday. The table is the one as entered,
+// so a Sunday starting at 00:00 is its own interval even after a Saturday that ends at midnight,
+// only an interval entered as overnight keeps its tail on the next day.
+func withoutDay(table []models.TimeInterval, weekday time.Weekday) []models.TimeInterval {
+	res := make([]models.TimeInterval, 0, len(table))
+	for _, interval := range table {
+		if interval.From.Weekday != weekday {
+			res = append(res, interval)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,88 @@
+package timetable
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+// SetExceptionForPlace replaces all overrides of the place for exception.Date.
+// An exception without intervals marks the place as closed for the whole day.
+func (s Service) SetExceptionForPlace(
+	ctx context.Context,
+	placeID uuid.UUID,
+	exception models.TimetableException,
+) (models.TimetableException, error) {
+	exist, err := s.db.PlaceExists(ctx, placeID)
+	if err != nil {
+		return models.TimetableException{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to check existence of place %s, cause: %w", placeID, err),
+		)
+	}
+	if !exist {
+		return models.TimetableException{}, errx.ErrorPlaceNotFound.Raise(
+			fmt.Errorf("place %s not found", placeID),
+		)
+	}
+
+	exception.Date = dateOf(exception.Date)
+	if err = validateDayIntervals(exception.Intervals); err != nil {
+		return models.TimetableException{}, errx.ErrorInvalidTimetableException.Raise(
+			fmt.Errorf("invalid exception for %s: %w", exception.Date.Format(time.DateOnly), err),
+		)
+	}
+
+	sort.Slice(exception.Intervals, func(i, j int) bool {
+		return exception.Intervals[i].From < exception.Intervals[j].From
+	})
+
+	if err = s.db.Transaction(ctx, func(ctx context.Context) error {
+		err = s.db.DeleteTimetableException(ctx, placeID, exception.Date)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("could not replace timetable exception, cause: %w", err),
+			)
+		}
+
+		err = s.db.SetTimetableException(ctx, placeID, exception)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("could not set timetable exception, cause: %w", err),
+			)
+		}
+
+		return nil
+	}); err != nil {
+		return models.TimetableException{}, err
+	}
+
+	return exception, nil
+}
+
+func validateDayIntervals(intervals []models.DayInterval) error {
+	sorted := make([]models.DayInterval, len(intervals))
+	copy(sorted, intervals)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].From < sorted[j].From })
+
+	for i, interval := range sorted {
+		if interval.From < 0 || interval.To > 24*time.Hour || interval.From >= interval.To {
+			return fmt.Errorf("interval %s-%s is out of the day or empty", interval.From, interval.To)
+		}
+		if i > 0 && interval.From < sorted[i-1].To {
+			return fmt.Errorf("interval %s-%s overlaps %s-%s",
+				interval.From, interval.To, sorted[i-1].From, sorted[i-1].To)
+		}
+	}
+
+	return nil
+}
+
+func dateOf(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
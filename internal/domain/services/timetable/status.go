@@ -0,0 +1,175 @@
+package timetable
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+// statusLookaheadDays limits how far ahead StatusForPlace searches for the next opening or closing.
+const statusLookaheadDays = 14
+
+// StatusForPlace reports whether the place is open at the instant at, and when it next opens or closes.
+// The instant is converted into the place local time, date exceptions override the weekly timetable
+// for their whole date, and intervals that cross midnight or the end of the week are followed through.
+func (s Service) StatusForPlace(ctx context.Context, placeID uuid.UUID, at time.Time) (models.TimetableStatus, error) {
+	place, err := s.db.GetPlaceByID(ctx, placeID, "")
+	if err != nil {
+		return models.TimetableStatus{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get place %s, cause: %w", placeID, err),
+		)
+	}
+	if place.IsNil() {
+		return models.TimetableStatus{}, errx.ErrorPlaceNotFound.Raise(
+			fmt.Errorf("place %s not found", placeID),
+		)
+	}
+
+	loc, err := time.LoadLocation(place.Timezone)
+	if err != nil {
+		return models.TimetableStatus{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to load timezone %q of place %s, cause: %w", place.Timezone, placeID, err),
+		)
+	}
+
+	weekly, err := s.db.GetTimetablesByPlaceID(ctx, placeID)
+	if err != nil {
+		return models.TimetableStatus{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("could not list timetable, cause: %w", err),
+		)
+	}
+
+	local := at.In(loc)
+	from := dateOf(local).AddDate(0, 0, -1)
+	to := dateOf(local).AddDate(0, 0, statusLookaheadDays)
+
+	exceptions, err := s.db.GetTimetableExceptions(ctx, placeID, &from, &to)
+	if err != nil {
+		return models.TimetableStatus{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("could not list timetable exceptions, cause: %w", err),
+		)
+	}
+
+	return timetableStatus(weekly, exceptions, local), nil
+}
+
+type openSpan struct {
+	from, to time.Time
+}
+
+// timetableStatus lays the timetable out on the calendar from the day before at (to catch an
+// overnight interval that is still running) up to the lookahead window, then finds where at falls.
+// Every date uses the weekly timetable in force on it, so a season change inside the window is followed.
+func timetableStatus(weekly []models.Timetable, exceptions []models.TimetableException, at time.Time) models.TimetableStatus {
+	loc := at.Location()
+
+	byDate := make(map[time.Time]models.TimetableException, len(exceptions))
+	for _, e := range exceptions {
+		byDate[dateOf(e.Date)] = e
+	}
+
+	y, m, d := at.Date()
+	var spans []openSpan
+	for i := -1; i <= statusLookaheadDays; i++ {
+		date := dateOf(time.Date(y, m, d+i, 0, 0, 0, 0, time.UTC))
+
+		var day []models.DayInterval
+		if e, ok := byDate[date]; ok {
+			day = e.Intervals
+		} else if tt, ok := models.ActiveTimetable(weekly, date); ok {
+			day = weeklyForDay(tt, date.Weekday())
+		}
+
+		for _, interval := range day {
+			spans = append(spans, openSpan{
+				from: time.Date(y, m, d+i, 0, int(interval.From/time.Minute), 0, 0, loc),
+				to:   time.Date(y, m, d+i, 0, int(interval.To/time.Minute), 0, 0, loc),
+			})
+		}
+	}
+	spans = mergeSpans(spans)
+
+	horizon := time.Date(y, m, d+statusLookaheadDays+1, 0, 0, 0, 0, loc)
+	status := models.TimetableStatus{At: at}
+
+	for i, span := range spans {
+		switch {
+		case !at.Before(span.from) && at.Before(span.to):
+			status.Open = true
+			if span.to.Before(horizon) {
+				status.NextClose = &span.to
+			}
+			if i+1 < len(spans) {
+				status.NextOpen = &spans[i+1].from
+			}
+			return status
+		case span.from.After(at):
+			status.NextOpen = &span.from
+			if span.to.Before(horizon) {
+				status.NextClose = &span.to
+			}
+			return status
+		}
+	}
+
+	return status
+}
+
+// weeklyForDay cuts the weekly intervals down to one weekday, as offsets from that day's midnight.
+func weeklyForDay(weekly models.Timetable, wd time.Weekday) []models.DayInterval {
+	const day = 24 * 60
+
+	dayStart := int(wd) * day
+	dayEnd := dayStart + day
+
+	var out []models.DayInterval
+	clip := func(start, end int) {
+		lo, hi := max(start, dayStart), min(end, dayEnd)
+		if lo < hi {
+			out = append(out, models.DayInterval{
+				From: time.Duration(lo-dayStart) * time.Minute,
+				To:   time.Duration(hi-dayStart) * time.Minute,
+			})
+		}
+	}
+
+	for _, interval := range weekly.Table {
+		start, end := interval.ToNumberMinutes()
+		if start < end {
+			clip(start, end)
+			continue
+		}
+		clip(start, models.WeekMinutes)
+		clip(0, end)
+	}
+
+	return out
+}
+
+// mergeSpans sorts spans and glues the ones that overlap or touch, e.g. 20:00–24:00 and 00:00–04:00.
+func mergeSpans(spans []openSpan) []openSpan {
+	if len(spans) == 0 {
+		return spans
+	}
+
+	sort.Slice(spans, func(i, j int) bool { return spans[i].from.Before(spans[j].from) })
+
+	out := []openSpan{spans[0]}
+	for _, span := range spans[1:] {
+		last := &out[len(out)-1]
+		if span.from.After(last.to) {
+			out = append(out, span)
+			continue
+		}
+		if span.to.After(last.to) {
+			last.to = span.to
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,118 @@
+package timetable
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type LinkTemplateParams struct {
+	TemplateID uuid.UUID
+	Name       string
+	ValidFrom  *time.Time
+	ValidTo    *time.Time
+}
+
+// LinkTemplateForPlace makes the named weekly timetable of the place follow a template of the place company.
+// The template intervals are copied into the timetable and copied again whenever the template changes.
+func (s Service) LinkTemplateForPlace(
+	ctx context.Context,
+	placeID uuid.UUID,
+	locale string,
+	params LinkTemplateParams,
+) (models.Place, error) {
+	place, err := s.db.GetPlaceByID(ctx, placeID, locale)
+	if err != nil {
+		return models.Place{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get place %s, cause: %w", placeID, err),
+		)
+	}
+	if place.IsNil() {
+		return models.Place{}, errx.ErrorPlaceNotFound.Raise(
+			fmt.Errorf("place %s not found", placeID),
+		)
+	}
+
+	template, err := s.db.GetTimetableTemplate(ctx, params.TemplateID)
+	if err != nil {
+		return models.Place{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get timetable template %s, cause: %w", params.TemplateID, err),
+		)
+	}
+	if template.IsNil() {
+		return models.Place{}, errx.ErrorTimetableTemplateNotFound.Raise(
+			fmt.Errorf("timetable template %s not found", params.TemplateID),
+		)
+	}
+
+	if place.CompanyID == nil || *place.CompanyID != template.CompanyID {
+		return models.Place{}, errx.ErrorTimetableTemplateOfOtherCompany.Raise(
+			fmt.Errorf("timetable template %s does not belong to company of place %s", template.ID, placeID),
+		)
+	}
+
+	return s.setForPlace(ctx, place, models.Timetable{
+		Name:       params.Name,
+		ValidFrom:  params.ValidFrom,
+		ValidTo:    params.ValidTo,
+		TemplateID: &template.ID,
+		Table:      template.Table,
+	})
+}
+
+// DetachTemplateForPlace unlinks the named weekly timetable of the place from its template,
+// the place keeps the intervals it had and stops following template changes.
+func (s Service) DetachTemplateForPlace(ctx context.Context, placeID uuid.UUID, name string) error {
+	if name == "" {
+		name = models.DefaultTimetableName
+	}
+
+	timetables, err := s.db.GetTimetablesByPlaceID(ctx, placeID)
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("could not list timetable, cause: %w", err),
+		)
+	}
+
+	var found *models.Timetable
+	for i := range timetables {
+		if timetables[i].Name == name {
+			found = &timetables[i]
+			break
+		}
+	}
+	if found == nil {
+		exist, err := s.db.PlaceExists(ctx, placeID)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to check existence of place %s, cause: %w", placeID, err),
+			)
+		}
+		if !exist {
+			return errx.ErrorPlaceNotFound.Raise(
+				fmt.Errorf("place %s not found", placeID),
+			)
+		}
+
+		return errx.ErrorTimetableNotFound.Raise(
+			fmt.Errorf("timetable %q of place %s not found", name, placeID),
+		)
+	}
+
+	if found.TemplateID == nil {
+		return nil
+	}
+
+	err = s.db.DetachTimetableTemplate(ctx, placeID, name, time.Now().UTC())
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("could not detach timetable from template, cause: %w", err),
+		)
+	}
+
+	return nil
+}
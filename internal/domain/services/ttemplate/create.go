@@ -0,0 +1,74 @@
+package ttemplate
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type CreateParams struct {
+	CompanyID uuid.UUID
+	Name      string
+	Table     []models.TimeInterval
+}
+
+func (s Service) Create(
+	ctx context.Context,
+	params CreateParams,
+) (models.TimetableTemplate, error) {
+	if err := models.ValidateWeekTable(params.Table); err != nil {
+		return models.TimetableTemplate{}, errx.ErrorInvalidTimetable.Raise(err)
+	}
+
+	exist, err := s.db.TimetableTemplateExistsByName(ctx, params.CompanyID, params.Name)
+	if err != nil {
+		return models.TimetableTemplate{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to check timetable template name existence, cause: %w", err),
+		)
+	}
+	if exist {
+		return models.TimetableTemplate{}, errx.ErrorTimetableTemplateNameAlreadyTaken.Raise(
+			fmt.Errorf("timetable template with name %s already exists", params.Name),
+		)
+	}
+
+	now := time.Now().UTC()
+	template := models.TimetableTemplate{
+		ID:        uuid.New(),
+		CompanyID: params.CompanyID,
+		Name:      params.Name,
+		Table:     sortedTable(params.Table),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err = s.db.Transaction(ctx, func(ctx context.Context) error {
+		err = s.db.CreateTimetableTemplate(ctx, template)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to create timetable template, cause: %w", err),
+			)
+		}
+
+		return nil
+	}); err != nil {
+		return models.TimetableTemplate{}, err
+	}
+
+	return template, nil
+}
+
+func sortedTable(table []models.TimeInterval) []models.TimeInterval {
+	res := make([]models.TimeInterval, len(table))
+	copy(res, table)
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].From.ToNumberMinutes() < res[j].From.ToNumberMinutes()
+	})
+
+	return res
+}
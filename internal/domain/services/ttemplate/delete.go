@@ -0,0 +1,26 @@
+package ttemplate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/google/uuid"
+)
+
+// Delete removes the template, the places linked to it are detached and keep the last copy of its intervals.
+func (s Service) Delete(ctx context.Context, templateID uuid.UUID) error {
+	_, err := s.Get(ctx, templateID)
+	if err != nil {
+		return err
+	}
+
+	err = s.db.DeleteTimetableTemplate(ctx, templateID)
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to delete timetable template %s, cause: %w", templateID, err),
+		)
+	}
+
+	return nil
+}
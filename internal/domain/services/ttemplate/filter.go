@@ -0,0 +1,25 @@
+package ttemplate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+func (s Service) Filter(
+	ctx context.Context,
+	companyID uuid.UUID,
+	page, size uint64,
+) (models.TimetableTemplatesCollection, error) {
+	templates, err := s.db.FilterTimetableTemplates(ctx, companyID, page, size)
+	if err != nil {
+		return models.TimetableTemplatesCollection{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to filter timetable templates of company %s, cause: %w", companyID, err),
+		)
+	}
+
+	return templates, nil
+}
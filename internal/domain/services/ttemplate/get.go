@@ -0,0 +1,27 @@
+package ttemplate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+func (s Service) Get(ctx context.Context, templateID uuid.UUID) (models.TimetableTemplate, error) {
+	template, err := s.db.GetTimetableTemplate(ctx, templateID)
+	if err != nil {
+		return models.TimetableTemplate{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get timetable template %s, cause: %w", templateID, err),
+		)
+	}
+
+	if template.IsNil() {
+		return models.TimetableTemplate{}, errx.ErrorTimetableTemplateNotFound.Raise(
+			fmt.Errorf("timetable template %s not found", templateID),
+		)
+	}
+
+	return template, nil
+}
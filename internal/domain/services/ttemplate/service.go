@@ -0,0 +1,33 @@
+package ttemplate
+
+import (
+	"context"
+	"time"
+
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type Service struct {
+	db database
+}
+
+func NewService(db database) Service {
+	return Service{db: db}
+}
+
+type database interface {
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+
+	CreateTimetableTemplate(ctx context.Context, template models.TimetableTemplate) error
+
+	GetTimetableTemplate(ctx context.Context, templateID uuid.UUID) (models.TimetableTemplate, error)
+	TimetableTemplateExistsByName(ctx context.Context, companyID uuid.UUID, name string) (bool, error)
+
+	FilterTimetableTemplates(ctx context.Context, companyID uuid.UUID, page, size uint64) (models.TimetableTemplatesCollection, error)
+
+	UpdateTimetableTemplate(ctx context.Context, templateID uuid.UUID, params UpdateParams, updatedAt time.Time) error
+	SyncTimetablesWithTemplate(ctx context.Context, templateID uuid.UUID, table []models.TimeInterval, updatedAt time.Time) error
+
+	DeleteTimetableTemplate(ctx context.Context, templateID uuid.UUID) error
+}
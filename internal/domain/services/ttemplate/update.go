@@ -0,0 +1,82 @@
+package ttemplate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+// UpdateParams changes the template name and/or replaces its intervals, nil fields are kept.
+type UpdateParams struct {
+	Name  *string
+	Table *[]models.TimeInterval
+}
+
+// Update changes the template, new intervals are copied to every place timetable linked to it
+// in the same transaction, so the places never show a mix of the old and new timetable.
+func (s Service) Update(ctx context.Context, templateID uuid.UUID, params UpdateParams) (models.TimetableTemplate, error) {
+	template, err := s.Get(ctx, templateID)
+	if err != nil {
+		return models.TimetableTemplate{}, err
+	}
+
+	if params.Name != nil && *params.Name != template.Name {
+		exist, err := s.db.TimetableTemplateExistsByName(ctx, template.CompanyID, *params.Name)
+		if err != nil {
+			return models.TimetableTemplate{}, errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to check timetable template name existence, cause: %w", err),
+			)
+		}
+		if exist {
+			return models.TimetableTemplate{}, errx.ErrorTimetableTemplateNameAlreadyTaken.Raise(
+				fmt.Errorf("timetable template with name %s already exists", *params.Name),
+			)
+		}
+
+		template.Name = *params.Name
+	}
+
+	if params.Table != nil {
+		if err = models.ValidateWeekTable(*params.Table); err != nil {
+			return models.TimetableTemplate{}, errx.ErrorInvalidTimetable.Raise(err)
+		}
+
+		table := sortedTable(*params.Table)
+		params.Table = &table
+		template.Table = table
+	}
+
+	now := time.Now().UTC()
+
+	if err = s.db.Transaction(ctx, func(ctx context.Context) error {
+		err = s.db.UpdateTimetableTemplate(ctx, templateID, params, now)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to update timetable template %s, cause: %w", templateID, err),
+			)
+		}
+
+		if params.Table == nil {
+			return nil
+		}
+
+		err = s.db.SyncTimetablesWithTemplate(ctx, templateID, template.Table, now)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to sync timetables with template %s, cause: %w", templateID, err),
+			)
+		}
+
+		return nil
+	}); err != nil {
+		return models.TimetableTemplate{}, err
+	}
+
+	template.UpdatedAt = now
+
+	return template, nil
+}
@@ -0,0 +1,59 @@
+package jobs
+
+import (
+	"context"
+	"time"
+
+	"github.com/chains-lab/logium"
+	"github.com/chains-lab/places-svc/internal"
+	"github.com/chains-lab/places-svc/internal/domain/services/place"
+)
+
+const (
+	defaultAddressesInterval = 5 * time.Second
+	defaultAddressesBatch    = 20
+)
+
+type AddressEnricher interface {
+	EnrichAddresses(ctx context.Context, limit uint64) (place.EnrichReport, error)
+}
+
+// RunAddresses looks up the addresses of pending places every interval until ctx is done,
+// a full batch is followed by the next one at once.
+func RunAddresses(ctx context.Context, cfg internal.Config, log logium.Logger, svc AddressEnricher) {
+	interval := cfg.Geocode.Enrichment.Interval
+	if interval <= 0 {
+		interval = defaultAddressesInterval
+	}
+	batch := cfg.Geocode.Enrichment.Batch
+	if batch == 0 {
+		batch = defaultAddressesBatch
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	log.Infof("place address enrichment started, every %s by %d", interval, batch)
+	for {
+		report, err := svc.EnrichAddresses(ctx, batch)
+		switch {
+		case err != nil:
+			log.WithError(err).Error("failed to enrich place addresses")
+		case report.Claimed > 0:
+			log.Infof("place addresses: %d resolved, %d to retry, %d failed",
+				report.Resolved, report.Retried, report.Failed)
+		}
+
+		// полная пачка — скорее всего, ждут ещё места
+		if err == nil && uint64(report.Claimed) == batch && ctx.Err() == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Info("place address enrichment stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -8,10 +8,12 @@ import (
 	"github.com/chains-lab/ape"
 	"github.com/chains-lab/ape/problems"
 	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/models"
 	"github.com/chains-lab/places-svc/internal/domain/services/place"
 	"github.com/chains-lab/places-svc/internal/rest/meta"
 	"github.com/chains-lab/places-svc/internal/rest/requests"
 	"github.com/chains-lab/places-svc/internal/rest/responses"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/paulmach/orb"
 )
 
@@ -33,16 +35,23 @@ func (s Service) CreatePlace(w http.ResponseWriter, r *http.Request) {
 	}
 
 	params := place.CreateParams{
-		CityID: req.Data.Attributes.CityId,
-		Class:  req.Data.Attributes.Class,
-		Point: orb.Point{
-			req.Data.Attributes.Point.Lon,
-			req.Data.Attributes.Point.Lat,
-		},
+		Class:       req.Data.Attributes.Class,
 		Locale:      req.Data.Attributes.Locale,
 		Name:        req.Data.Attributes.Name,
 		Description: req.Data.Attributes.Description,
 	}
+	if req.Data.Attributes.CityId != nil {
+		params.CityID = *req.Data.Attributes.CityId
+	}
+	if req.Data.Attributes.Point != nil {
+		params.Point = orb.Point{
+			req.Data.Attributes.Point.Lon,
+			req.Data.Attributes.Point.Lat,
+		}
+	}
+	if req.Data.Attributes.Address != nil {
+		params.Address = *req.Data.Attributes.Address
+	}
 	if req.Data.Attributes.DistributorId != nil {
 		params.DistributorID = req.Data.Attributes.DistributorId
 	}
@@ -52,13 +61,26 @@ func (s Service) CreatePlace(w http.ResponseWriter, r *http.Request) {
 	if req.Data.Attributes.Website != nil {
 		params.Website = req.Data.Attributes.Website
 	}
+	if req.Data.Attributes.Timezone != nil {
+		params.Timezone = req.Data.Attributes.Timezone
+	}
 
 	res, err := s.domain.place.Create(r.Context(), params)
 	if err != nil {
 		s.log.WithError(err).Error("error creating place")
 		switch {
+		case errors.Is(err, errx.ErrorAddressNotFound), errors.Is(err, errx.ErrorAddressAmbiguous):
+			renderAddressErr(w, err)
 		case errors.Is(err, errx.ErrorClassNotFound):
 			ape.RenderErr(w, problems.NotFound(fmt.Sprintf("class with code %s not found", params.Class)))
+		case errors.Is(err, errx.ErrorPlaceOutsideCity), errors.Is(err, errx.ErrorPlaceCityNotFound):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"data/attributes/city_id": err,
+			})...)
+		case errors.Is(err, errx.ErrorInvalidTimezone):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"data/attributes/timezone": err,
+			})...)
 		default:
 			ape.RenderErr(w, problems.InternalError())
 		}
@@ -70,3 +92,17 @@ func (s Service) CreatePlace(w http.ResponseWriter, r *http.Request) {
 
 	ape.Render(w, http.StatusCreated, responses.Place(res))
 }
+
+// renderAddressErr renders a failed geocoding of the address: no match is a bad request,
+// several matches are the candidates to resubmit with a point.
+func renderAddressErr(w http.ResponseWriter, err error) {
+	var ambiguous *models.AmbiguousAddress
+	if errors.As(err, &ambiguous) {
+		ape.Render(w, http.StatusMultipleChoices, responses.GeocodeCandidates(ambiguous.Candidates))
+		return
+	}
+
+	ape.RenderErr(w, problems.BadRequest(validation.Errors{
+		"data/attributes/address": err,
+	})...)
+}
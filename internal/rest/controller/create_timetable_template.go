@@ -0,0 +1,132 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/chains-lab/ape"
+	"github.com/chains-lab/ape/problems"
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/chains-lab/places-svc/internal/domain/services/ttemplate"
+	"github.com/chains-lab/places-svc/internal/rest/meta"
+	"github.com/chains-lab/places-svc/internal/rest/requests"
+	"github.com/chains-lab/places-svc/internal/rest/responses"
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+)
+
+// роли в компании, которым можно менять шаблоны расписаний (как companyModer / companyAdmin в роутере)
+var (
+	templateModerRoles = map[string]bool{"owner": true, "admin": true, "moder": true}
+	templateAdminRoles = map[string]bool{"owner": true, "admin": true}
+)
+
+func (s Service) CreateTimetableTemplate(w http.ResponseWriter, r *http.Request) {
+	companyID, ok := s.userCompany(w, r, templateModerRoles)
+	if !ok {
+		return
+	}
+
+	req, err := requests.CreateTimetableTemplate(r)
+	if err != nil {
+		s.log.WithError(err).Error("invalid request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+		return
+	}
+
+	table, err := parseTimetableTable(req.Data.Attributes.Table)
+	if err != nil {
+		ape.RenderErr(w, problems.BadRequest(err)...)
+		return
+	}
+
+	res, err := s.domain.ttemplate.Create(r.Context(), ttemplate.CreateParams{
+		CompanyID: companyID,
+		Name:      req.Data.Attributes.Name,
+		Table:     table,
+	})
+	if err != nil {
+		s.log.WithError(err).Error("could not create timetable template")
+		switch {
+		case errors.Is(err, errx.ErrorInvalidTimetable):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"data/attributes/table": err,
+			})...)
+		case errors.Is(err, errx.ErrorTimetableTemplateNameAlreadyTaken):
+			ape.RenderErr(w, problems.Conflict(fmt.Sprintf("timetable template %s already exists", req.Data.Attributes.Name)))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	ape.Render(w, http.StatusCreated, responses.TimetableTemplate(res))
+}
+
+// userCompany returns the company of the initiator, who must have one of allowedRoles in it
+// (any role when allowedRoles is nil). On failure the error is already rendered.
+func (s Service) userCompany(w http.ResponseWriter, r *http.Request, allowedRoles map[string]bool) (uuid.UUID, bool) {
+	user, err := meta.User(r.Context())
+	if err != nil {
+		s.log.WithError(err).Error("failed to get user from context")
+		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
+		return uuid.Nil, false
+	}
+
+	if user.CompanyID == nil {
+		ape.RenderErr(w, problems.Forbidden("User is not associated with any company"))
+		return uuid.Nil, false
+	}
+
+	if allowedRoles != nil && !allowedRoles[user.Role] {
+		ape.RenderErr(w, problems.Forbidden("User does not have the required role"))
+		return uuid.Nil, false
+	}
+
+	return *user.CompanyID, true
+}
+
+// companyTemplate loads the template from the URL and checks it belongs to the initiator company.
+// On failure the error is already rendered.
+func (s Service) companyTemplate(
+	w http.ResponseWriter,
+	r *http.Request,
+	allowedRoles map[string]bool,
+) (models.TimetableTemplate, bool) {
+	templateID, err := uuid.Parse(chi.URLParam(r, "template_id"))
+	if err != nil {
+		s.log.WithError(err).Error("invalid template_id")
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"query": fmt.Errorf("failed to parse template_id: %w", err),
+		})...)
+		return models.TimetableTemplate{}, false
+	}
+
+	companyID, ok := s.userCompany(w, r, allowedRoles)
+	if !ok {
+		return models.TimetableTemplate{}, false
+	}
+
+	template, err := s.domain.ttemplate.Get(r.Context(), templateID)
+	if err != nil {
+		s.log.WithError(err).Error("failed to get timetable template")
+		switch {
+		case errors.Is(err, errx.ErrorTimetableTemplateNotFound):
+			ape.RenderErr(w, problems.NotFound("timetable template not found"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+		return models.TimetableTemplate{}, false
+	}
+
+	if template.CompanyID != companyID {
+		ape.RenderErr(w, problems.Forbidden("User does not belong to the company associated with the template"))
+		return models.TimetableTemplate{}, false
+	}
+
+	return template, true
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/chains-lab/ape"
 	"github.com/chains-lab/ape/problems"
@@ -24,12 +25,18 @@ func (s Service) DeleteTimetable(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = s.domain.timetable.DeleteForPlace(r.Context(), placeID)
+	if name := strings.TrimSpace(r.URL.Query().Get("name")); name != "" {
+		err = s.domain.timetable.DeleteNamedForPlace(r.Context(), placeID, name)
+	} else {
+		err = s.domain.timetable.DeleteForPlace(r.Context(), placeID)
+	}
 	if err != nil {
 		s.log.WithError(err).Error("failed to delete timetable")
 		switch {
 		case errors.Is(err, errx.ErrorPlaceNotFound):
 			ape.RenderErr(w, problems.NotFound("place not found"))
+		case errors.Is(err, errx.ErrorTimetableNotFound):
+			ape.RenderErr(w, problems.NotFound("timetable not found"))
 		default:
 			ape.RenderErr(w, problems.InternalError())
 		}
@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/chains-lab/ape"
+	"github.com/chains-lab/ape/problems"
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+)
+
+func (s Service) DeleteTimetableDay(w http.ResponseWriter, r *http.Request) {
+	placeID, err := uuid.Parse(chi.URLParam(r, "place_id"))
+	if err != nil {
+		s.log.WithError(err).Error("invalid place_id")
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"query": fmt.Errorf("failed to parse place_id: %w", err),
+		})...)
+
+		return
+	}
+
+	weekday, err := parseWeekday(chi.URLParam(r, "weekday"))
+	if err != nil {
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"weekday": err,
+		})...)
+		return
+	}
+
+	name := strings.TrimSpace(r.URL.Query().Get("name"))
+
+	err = s.domain.timetable.DeleteDayForPlace(r.Context(), placeID, name, weekday)
+	if err != nil {
+		s.log.WithError(err).Error("failed to delete timetable day")
+		switch {
+		case errors.Is(err, errx.ErrorPlaceNotFound):
+			ape.RenderErr(w, problems.NotFound("place not found"))
+		case errors.Is(err, errx.ErrorTimetableNotFound):
+			ape.RenderErr(w, problems.NotFound("timetable not found"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	ape.Render(w, http.StatusNoContent, nil)
+}
@@ -0,0 +1,54 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/chains-lab/ape"
+	"github.com/chains-lab/ape/problems"
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+)
+
+func (s Service) DeleteTimetableException(w http.ResponseWriter, r *http.Request) {
+	placeID, err := uuid.Parse(chi.URLParam(r, "place_id"))
+	if err != nil {
+		s.log.WithError(err).Error("invalid place_id")
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"query": fmt.Errorf("failed to parse place_id: %w", err),
+		})...)
+
+		return
+	}
+
+	date, err := time.Parse(time.DateOnly, chi.URLParam(r, "date"))
+	if err != nil {
+		s.log.WithError(err).Error("invalid date")
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"query": fmt.Errorf("failed to parse date (YYYY-MM-DD): %w", err),
+		})...)
+
+		return
+	}
+
+	err = s.domain.timetable.DeleteExceptionForPlace(r.Context(), placeID, date)
+	if err != nil {
+		s.log.WithError(err).Error("failed to delete timetable exception")
+		switch {
+		case errors.Is(err, errx.ErrorPlaceNotFound):
+			ape.RenderErr(w, problems.NotFound("place not found"))
+		case errors.Is(err, errx.ErrorTimetableExceptionNotFound):
+			ape.RenderErr(w, problems.NotFound("timetable exception not found"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	ape.Render(w, http.StatusNoContent, nil)
+}
@@ -0,0 +1,32 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/chains-lab/ape"
+	"github.com/chains-lab/ape/problems"
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+)
+
+func (s Service) DeleteTimetableTemplate(w http.ResponseWriter, r *http.Request) {
+	template, ok := s.companyTemplate(w, r, templateAdminRoles)
+	if !ok {
+		return
+	}
+
+	err := s.domain.ttemplate.Delete(r.Context(), template.ID)
+	if err != nil {
+		s.log.WithError(err).Error("failed to delete timetable template")
+		switch {
+		case errors.Is(err, errx.ErrorTimetableTemplateNotFound):
+			ape.RenderErr(w, problems.NotFound("timetable template not found"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	ape.Render(w, http.StatusNoContent, nil)
+}
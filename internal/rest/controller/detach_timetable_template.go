@@ -0,0 +1,48 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/chains-lab/ape"
+	"github.com/chains-lab/ape/problems"
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+)
+
+// DetachTimetableTemplate stops the timetable ?name= (default one when omitted) following its template,
+// the place keeps the current intervals as a local timetable.
+func (s Service) DetachTimetableTemplate(w http.ResponseWriter, r *http.Request) {
+	placeID, err := uuid.Parse(chi.URLParam(r, "place_id"))
+	if err != nil {
+		s.log.WithError(err).Error("invalid place_id")
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"query": fmt.Errorf("failed to parse place_id: %w", err),
+		})...)
+
+		return
+	}
+
+	name := strings.TrimSpace(r.URL.Query().Get("name"))
+
+	err = s.domain.timetable.DetachTemplateForPlace(r.Context(), placeID, name)
+	if err != nil {
+		s.log.WithError(err).Error("failed to detach timetable template")
+		switch {
+		case errors.Is(err, errx.ErrorPlaceNotFound):
+			ape.RenderErr(w, problems.NotFound("place not found"))
+		case errors.Is(err, errx.ErrorTimetableNotFound):
+			ape.RenderErr(w, problems.NotFound("timetable not found"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	ape.Render(w, http.StatusNoContent, nil)
+}
@@ -0,0 +1,208 @@
+package controller
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/chains-lab/ape"
+	"github.com/chains-lab/ape/problems"
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/chains-lab/places-svc/internal/rest/meta"
+	"github.com/chains-lab/places-svc/internal/rest/responses"
+	"github.com/chains-lab/restkit/roles"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	exportFormatNDJSON = "ndjson"
+	exportFormatCSV    = "csv"
+
+	// exportFlushRows is the number of rows written between flushes of the response.
+	exportFlushRows = 100
+)
+
+// роли в компании, которым можно выгружать места своей компании
+var exportCompanyRoles = map[string]bool{"owner": true}
+
+func (s Service) ExportPlaces(w http.ResponseWriter, r *http.Request) {
+	initiator, err := meta.User(r.Context())
+	if err != nil {
+		s.log.WithError(err).Error("failed to get user from context")
+		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
+
+		return
+	}
+
+	filters, _, err := placeFilters(r.URL.Query())
+	if err != nil {
+		ape.RenderErr(w, problems.BadRequest(err)...)
+		return
+	}
+
+	if initiator.Role != roles.Admin {
+		companyID, ok := s.userCompany(w, r, exportCompanyRoles)
+		if !ok {
+			return
+		}
+		filters.CompanyID = &companyID
+	}
+
+	format, err := exportFormat(r)
+	if err != nil {
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"format": err,
+		})...)
+
+		return
+	}
+
+	out := newPlacesExportWriter(w, format, DetectLocale(w, r))
+
+	err = s.domain.place.Export(r.Context(), filters, out.write)
+	if err == nil {
+		err = out.finish()
+	}
+	if err != nil {
+		s.log.WithError(err).Errorf("failed to export places after %d rows", out.rows)
+		// статус уже отправлен, клиент увидит оборванный поток
+		if out.started {
+			return
+		}
+
+		switch {
+		case errors.Is(err, errx.ErrorInvalidSearchArea):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"bbox": err,
+			})...)
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	s.log.Infof("places export by user %s: %d rows as %s", initiator.ID, out.rows, format)
+}
+
+// exportFormat picks the format of the export from the format param or the Accept header, NDJSON by default.
+func exportFormat(r *http.Request) (string, error) {
+	switch format := r.URL.Query().Get("format"); format {
+	case exportFormatNDJSON, exportFormatCSV:
+		return format, nil
+	case "":
+	default:
+		return "", fmt.Errorf("unsupported format %q, expected 'ndjson' or 'csv'", format)
+	}
+
+	for _, accepted := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accepted))
+		if err == nil && mediaType == responses.CSVContentType {
+			return exportFormatCSV, nil
+		}
+	}
+
+	return exportFormatNDJSON, nil
+}
+
+// placesExportWriter streams exported places to the response. The headers are written with the first row,
+// so an error before it can still be rendered as a problem.
+type placesExportWriter struct {
+	w      http.ResponseWriter
+	rc     *http.ResponseController
+	format string
+	locale string
+
+	started bool
+	ndjson  *json.Encoder
+	csv     *csv.Writer
+	rows    uint64
+}
+
+func newPlacesExportWriter(w http.ResponseWriter, format, locale string) *placesExportWriter {
+	return &placesExportWriter{
+		w:      w,
+		rc:     http.NewResponseController(w),
+		format: format,
+		locale: locale,
+	}
+}
+
+func (e *placesExportWriter) start() error {
+	e.started = true
+
+	contentType, ext := responses.NDJSONContentType, exportFormatNDJSON
+	if e.format == exportFormatCSV {
+		contentType, ext = responses.CSVContentType+"; charset=utf-8", exportFormatCSV
+	}
+
+	e.w.Header().Set("Content-Type", contentType)
+	e.w.Header().Set("Content-Disposition", fmt.Sprintf(
+		"attachment; filename=\"places-%s.%s\"", time.Now().UTC().Format("20060102T150405Z"), ext,
+	))
+	e.w.Header().Add("Vary", "Accept")
+	e.w.WriteHeader(http.StatusOK)
+
+	if e.format == exportFormatCSV {
+		e.csv = csv.NewWriter(e.w)
+		return e.csv.Write(responses.PlaceExportCSVHeader())
+	}
+
+	e.ndjson = json.NewEncoder(e.w)
+	return nil
+}
+
+func (e *placesExportWriter) write(p models.PlaceExport) error {
+	if !e.started {
+		if err := e.start(); err != nil {
+			return err
+		}
+	}
+	e.rows++
+
+	var err error
+	if e.csv != nil {
+		err = e.csv.Write(responses.PlaceExportCSVRecord(p, e.locale))
+	} else {
+		err = e.ndjson.Encode(responses.PlaceExport(p))
+	}
+	if err != nil {
+		return err
+	}
+
+	if e.rows%exportFlushRows == 0 {
+		return e.flush()
+	}
+	return nil
+}
+
+// finish completes the export, an export without rows is an empty NDJSON body or a CSV header.
+func (e *placesExportWriter) finish() error {
+	if !e.started {
+		if err := e.start(); err != nil {
+			return err
+		}
+	}
+
+	return e.flush()
+}
+
+func (e *placesExportWriter) flush() error {
+	if e.csv != nil {
+		e.csv.Flush()
+		if err := e.csv.Error(); err != nil {
+			return err
+		}
+	}
+
+	if err := e.rc.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	return nil
+}
@@ -4,43 +4,199 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/chains-lab/ape"
 	"github.com/chains-lab/ape/problems"
+	"github.com/chains-lab/places-svc/internal/domain/errx"
 	"github.com/chains-lab/places-svc/internal/domain/models"
 	"github.com/chains-lab/places-svc/internal/domain/services/place"
 	"github.com/chains-lab/places-svc/internal/rest/responses"
 	"github.com/chains-lab/restkit/pagi"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/google/uuid"
+	"github.com/paulmach/orb"
 )
 
 func (s Service) FilterPlace(w http.ResponseWriter, r *http.Request) {
-	q := r.URL.Query()
-	var filters place.FilterParams
+	s.filterPlaces(w, r, nil)
+}
 
-	if cityID := strings.TrimSpace(q.Get("city_id")); cityID != "" {
-		id, err := uuid.Parse(cityID)
+// filterPlaces lists places by the query params, area comes from the body of a POST search.
+func (s Service) filterPlaces(w http.ResponseWriter, r *http.Request, area orb.Geometry) {
+	filters, _, err := placeFilters(r.URL.Query())
+	if err != nil {
+		ape.RenderErr(w, problems.BadRequest(err)...)
+		return
+	}
+	filters.Area = area
+
+	geoJSON, err := wantsGeoJSON(w, r)
+	if err != nil {
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"format": err,
+		})...)
+
+		return
+	}
+
+	pag, size := pagi.GetPagination(r)
+
+	sorts := pagi.SortFields(r)
+
+	sort := place.SortParams{}
+	for _, s := range sorts {
+		switch s.Field {
+		case "created_at":
+			sort.ByCreatedAt = &s.Ascend
+		case "distance":
+			// без радиуса нет фильтра по расстоянию, и сортировать не от чего ни на странице, ни по курсору
+			if filters.Location == nil {
+				ape.RenderErr(w, problems.BadRequest(validation.Errors{
+					"sort": errors.New("the 'point' and 'radius' parameters are required when sorting by distance"),
+				})...)
+
+				return
+			}
+
+			sort.ByDistance = &s.Ascend
+		case "relevance":
+			if filters.Search == nil {
+				ape.RenderErr(w, problems.BadRequest(validation.Errors{
+					"sort": errors.New("the 'q' parameter is required when sorting by relevance"),
+				})...)
+
+				return
+			}
+
+			sort.ByRank = &s.Ascend
+		}
+
+	}
+
+	skipTotal := false
+	if count := strings.TrimSpace(r.URL.Query().Get("count")); count != "" {
+		v, err := parseBoolParam(count)
 		if err != nil {
-			s.log.WithError(err).Error("invalid city_id")
 			ape.RenderErr(w, problems.BadRequest(validation.Errors{
-				"query": fmt.Errorf("failed to parse city_id: %w", err),
+				"count": err,
+			})...)
+
+			return
+		}
+		skipTotal = !v
+	}
+
+	var facets []string
+	if v := strings.TrimSpace(r.URL.Query().Get("facets")); v != "" {
+		for _, facet := range strings.Split(v, ",") {
+			if facet = strings.TrimSpace(facet); facet != "" {
+				facets = append(facets, facet)
+			}
+		}
+	}
+
+	var places models.PlacesCollection
+	if r.URL.Query().Has("cursor") {
+		places, err = s.domain.place.FilterByCursor(r.Context(), DetectLocale(w, r), filters, sort, place.CursorParams{
+			Cursor:    strings.TrimSpace(r.URL.Query().Get("cursor")),
+			Size:      size,
+			SkipTotal: skipTotal,
+		})
+	} else {
+		places, err = s.domain.place.FilterPage(r.Context(), DetectLocale(w, r), filters, sort, place.PageParams{
+			Page:      pag,
+			Size:      size,
+			SkipTotal: skipTotal,
+		})
+	}
+	if err != nil {
+		s.log.WithError(err).Error("failed to filter places")
+		switch {
+		case errors.Is(err, errx.ErrorInvalidPlacesCursor):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"cursor": err,
+			})...)
+		case errors.Is(err, errx.ErrorInvalidSearchArea):
+			field := "bbox"
+			if area != nil {
+				field = "data/attributes"
+			}
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				field: err,
 			})...)
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	if len(facets) > 0 {
+		res, err := s.domain.place.Facets(r.Context(), filters, facets)
+		if err != nil {
+			s.log.WithError(err).Error("failed to count place facets")
+			switch {
+			case errors.Is(err, errx.ErrorInvalidPlaceFacet):
+				ape.RenderErr(w, problems.BadRequest(validation.Errors{
+					"facets": err,
+				})...)
+			default:
+				ape.RenderErr(w, problems.InternalError())
+			}
+
+			return
+		}
+		places.Facets = &res
+	}
+
+	if geoJSON {
+		res, err := responses.PlacesFeatureCollection(places)
+		if err != nil {
+			s.log.WithError(err).Error("failed to build places geojson")
+			ape.RenderErr(w, problems.InternalError())
 			return
 		}
+
+		s.renderGeoJSON(w, http.StatusOK, res)
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.PlacesCollection(places))
+}
+
+// placeFilters parses the FilterPlace query params, they are shared by every endpoint listing places.
+// geo is the point (and radius, if any) of the query, it is set even without a radius filter.
+func placeFilters(q url.Values) (filters place.FilterParams, geo *place.FilterDistance, err error) {
+	if cityID := strings.TrimSpace(q.Get("city_id")); cityID != "" {
+		id, err := uuid.Parse(cityID)
+		if err != nil {
+			return place.FilterParams{}, nil, validation.Errors{
+				"query": fmt.Errorf("failed to parse city_id: %w", err),
+			}
+		}
 		filters.CityID = &id
 	}
 
+	if districtID := strings.TrimSpace(q.Get("district_id")); districtID != "" {
+		id, err := uuid.Parse(districtID)
+		if err != nil {
+			return place.FilterParams{}, nil, validation.Errors{
+				"query": fmt.Errorf("failed to parse district_id: %w", err),
+			}
+		}
+		filters.DistrictID = &id
+	}
+
 	if distributorID := strings.TrimSpace(q.Get("place_id")); distributorID != "" {
 		id, err := uuid.Parse(distributorID)
 		if err != nil {
-			s.log.WithError(err).Error("invalid place_id")
-			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			return place.FilterParams{}, nil, validation.Errors{
 				"query": fmt.Errorf("failed to parse place_id: %w", err),
-			})...)
-			return
+			}
 		}
 		filters.CompanyID = &id
 	}
@@ -53,6 +209,44 @@ func (s Service) FilterPlace(w http.ResponseWriter, r *http.Request) {
 		filters.Address = &[]string{address}[0]
 	}
 
+	if code := strings.TrimSpace(q.Get("country_code")); code != "" {
+		if len(code) != 2 {
+			return place.FilterParams{}, nil, validation.Errors{
+				"country_code": fmt.Errorf("invalid country code %q, expected ISO 3166-1 alpha-2", code),
+			}
+		}
+		filters.CountryCode = &code
+	}
+
+	if postcode := strings.TrimSpace(q.Get("postcode")); postcode != "" {
+		filters.Postcode = &postcode
+	}
+
+	if street := strings.TrimSpace(q.Get("street")); street != "" {
+		filters.Street = &street
+	}
+
+	if text := strings.TrimSpace(q.Get("q")); text != "" {
+		filters.Search = &place.FilterSearch{Query: text}
+	}
+
+	if highlight := strings.TrimSpace(q.Get("highlight")); highlight != "" {
+		switch highlight {
+		case "true":
+			if filters.Search == nil {
+				return place.FilterParams{}, nil, validation.Errors{
+					"highlight": errors.New("the 'q' parameter is required when 'highlight' is provided"),
+				}
+			}
+			filters.Search.Highlight = true
+		case "false":
+		default:
+			return place.FilterParams{}, nil, validation.Errors{
+				"highlight": fmt.Errorf("invalid highlight value: %s", highlight),
+			}
+		}
+	}
+
 	if classes := q["class"]; len(classes) > 0 {
 		filters.Classes = classes
 	}
@@ -62,44 +256,32 @@ func (s Service) FilterPlace(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if verified := strings.TrimSpace(q.Get("verified")); verified != "" {
-		switch verified {
-		case "true":
-			t := true
-			filters.Verified = &t
-		case "false":
-			f := false
-			filters.Verified = &f
-		default:
-			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+		v, err := parseBoolParam(verified)
+		if err != nil {
+			return place.FilterParams{}, nil, validation.Errors{
 				"query": fmt.Errorf("invalid verified value: %s", verified),
-			})...)
-			return
+			}
 		}
+		filters.Verified = &v
 	}
 
-	var geo *place.FilterDistance
 	if point := strings.TrimSpace(q.Get("point")); point != "" {
 		parts := strings.Split(point, ",")
 		if len(parts) != 2 {
-			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			return place.FilterParams{}, nil, validation.Errors{
 				"point": fmt.Errorf("expected 'lon,lat', got %q", point),
-			})...)
-
-			return
+			}
 		}
 		var lon, lat float64
 		if _, err := fmt.Sscanf(parts[0], "%f", &lon); err != nil {
-			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			return place.FilterParams{}, nil, validation.Errors{
 				"point": fmt.Errorf("invalid longitude value: %v", err),
-			})...)
-
-			return
+			}
 		}
 		if _, err := fmt.Sscanf(parts[1], "%f", &lat); err != nil {
-			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			return place.FilterParams{}, nil, validation.Errors{
 				"point": fmt.Errorf("invalid latitude value: %v", err),
-			})...)
-			return
+			}
 		}
 		geo = &place.FilterDistance{Point: [2]float64{lon, lat}}
 	}
@@ -107,18 +289,14 @@ func (s Service) FilterPlace(w http.ResponseWriter, r *http.Request) {
 	if radius := strings.TrimSpace(q.Get("radius")); radius != "" {
 		var rM uint64
 		if _, err := fmt.Sscanf(radius, "%d", &rM); err != nil || rM == 0 {
-			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			return place.FilterParams{}, nil, validation.Errors{
 				"radius": fmt.Errorf("invalid radius value: %v", err),
-			})...)
-
-			return
+			}
 		}
 		if geo == nil {
-			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			return place.FilterParams{}, nil, validation.Errors{
 				"radius": errors.New("the 'point' parameter is required when 'radius' is provided"),
-			})...)
-
-			return
+			}
 		}
 		geo.RadiusM = rM
 	}
@@ -127,64 +305,102 @@ func (s Service) FilterPlace(w http.ResponseWriter, r *http.Request) {
 		filters.Location = geo
 	}
 
+	if bbox := strings.TrimSpace(q.Get("bbox")); bbox != "" {
+		b, err := parseBBoxParam(bbox)
+		if err != nil {
+			return place.FilterParams{}, nil, validation.Errors{
+				"bbox": err,
+			}
+		}
+		filters.BBox = &b
+	}
+
 	tf := strings.TrimSpace(q.Get("time_from"))
 	tt := strings.TrimSpace(q.Get("time_to"))
 	if tf != "" || tt != "" {
 		if tf == "" || tt == "" {
-			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			return place.FilterParams{}, nil, validation.Errors{
 				"time": errors.New("both 'time_from' and 'time_to' parameters are required"),
-			})...)
-
-			return
+			}
 		}
 		from, err := parseMomentParam(tf)
 		if err != nil {
-			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			return place.FilterParams{}, nil, validation.Errors{
 				"time_from": err,
-			})...)
-
-			return
+			}
 		}
 		to, err := parseMomentParam(tt)
 		if err != nil {
-			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			return place.FilterParams{}, nil, validation.Errors{
 				"time_to": err,
-			})...)
-
-			return
+			}
 		}
 		filters.Time = &models.TimeInterval{From: from, To: to}
 	}
 
-	pag, size := pagi.GetPagination(r)
+	openNow := strings.TrimSpace(q.Get("open_now"))
+	openAt := strings.TrimSpace(q.Get("open_at"))
+	if openNow != "" && openAt != "" {
+		return place.FilterParams{}, nil, validation.Errors{
+			"open_at": errors.New("'open_now' and 'open_at' parameters cannot be used together"),
+		}
+	}
+	if openNow != "" {
+		// "закрыто сейчас" не поддерживается, поэтому false отклоняется, а не игнорируется
+		if openNow != "true" {
+			return place.FilterParams{}, nil, validation.Errors{
+				"open_now": fmt.Errorf("expected 'true', got %q", openNow),
+			}
+		}
+		now := time.Now().UTC()
+		filters.OpenAt = &now
+	}
+	if openAt != "" {
+		at, err := time.Parse(time.RFC3339, openAt)
+		if err != nil {
+			return place.FilterParams{}, nil, validation.Errors{
+				"open_at": fmt.Errorf("expected RFC3339 timestamp, got %q", openAt),
+			}
+		}
+		filters.OpenAt = &at
+	}
 
-	sorts := pagi.SortFields(r)
+	return filters, geo, nil
+}
 
-	sort := place.SortParams{}
-	for _, s := range sorts {
-		switch s.Field {
-		case "created_at":
-			sort.ByCreatedAt = &s.Ascend
-		case "distance":
-			if geo == nil {
-				ape.RenderErr(w, problems.BadRequest(validation.Errors{
-					"sort": errors.New("the 'point' parameter is required when sorting by distance"),
-				})...)
+func parseBoolParam(v string) (bool, error) {
+	switch v {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	default:
+		return false, fmt.Errorf("expected 'true' or 'false', got %q", v)
+	}
+}
 
-				return
-			}
+// parseBBoxParam parses minLon,minLat,maxLon,maxLat; minLon greater than maxLon is a box over the antimeridian.
+func parseBBoxParam(v string) (models.BBox, error) {
+	parts := strings.Split(v, ",")
+	if len(parts) != 4 {
+		return models.BBox{}, fmt.Errorf("expected 'minLon,minLat,maxLon,maxLat', got %q", v)
+	}
 
-			sort.ByDistance = &s.Ascend
+	var vals [4]float64
+	for i, part := range parts {
+		f, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			return models.BBox{}, fmt.Errorf("invalid bbox value %q", part)
 		}
-
+		vals[i] = f
 	}
 
-	places, err := s.domain.place.Filter(r.Context(), DetectLocale(w, r), filters, sort, pag, size)
-	if err != nil {
-		ape.RenderErr(w, problems.InternalError())
-		return
+	b := models.BBox{MinLon: vals[0], MinLat: vals[1], MaxLon: vals[2], MaxLat: vals[3]}
+	if err := b.Validate(); err != nil {
+		return models.BBox{}, err
 	}
-	ape.Render(w, http.StatusOK, responses.PlacesCollection(places))
+
+	return b, nil
 }
 
 func parseMomentParam(v string) (models.Moment, error) {
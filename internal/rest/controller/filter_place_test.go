@@ -0,0 +1,22 @@
+package controller
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestPlaceFiltersOpenNow(t *testing.T) {
+	filters, _, err := placeFilters(url.Values{"open_now": {"true"}})
+	if err != nil {
+		t.Fatalf("placeFilters: %v", err)
+	}
+	if filters.OpenAt == nil {
+		t.Fatalf("expected open_now=true to filter by the current moment")
+	}
+
+	for _, v := range []string{"false", "1", "yes"} {
+		if _, _, err := placeFilters(url.Values{"open_now": {v}}); err == nil {
+			t.Fatalf("expected open_now=%s to be rejected", v)
+		}
+	}
+}
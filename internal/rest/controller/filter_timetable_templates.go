@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/chains-lab/ape"
+	"github.com/chains-lab/ape/problems"
+	"github.com/chains-lab/places-svc/internal/rest/responses"
+	"github.com/chains-lab/restkit/pagi"
+)
+
+func (s Service) FilterTimetableTemplates(w http.ResponseWriter, r *http.Request) {
+	companyID, ok := s.userCompany(w, r, nil)
+	if !ok {
+		return
+	}
+
+	pag, size := pagi.GetPagination(r)
+
+	templates, err := s.domain.ttemplate.Filter(r.Context(), companyID, pag, size)
+	if err != nil {
+		s.log.WithError(err).Error("failed to list timetable templates")
+		ape.RenderErr(w, problems.InternalError())
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.TimetableTemplatesCollection(templates))
+}
@@ -0,0 +1,45 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/chains-lab/places-svc/internal/rest/responses"
+)
+
+// wantsGeoJSON reports whether the client asked for GeoJSON with format=geojson or the Accept header.
+// An explicit format=json wins over the header. Both representations depend on Accept,
+// so Vary is set on w whatever the answer is.
+func wantsGeoJSON(w http.ResponseWriter, r *http.Request) (bool, error) {
+	w.Header().Add("Vary", "Accept")
+
+	switch format := r.URL.Query().Get("format"); format {
+	case "geojson":
+		return true, nil
+	case "json":
+		return false, nil
+	case "":
+	default:
+		return false, fmt.Errorf("unsupported format %q, expected 'json' or 'geojson'", format)
+	}
+
+	for _, accepted := range strings.Split(r.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accepted))
+		if err == nil && mediaType == responses.GeoJSONContentType {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (s Service) renderGeoJSON(w http.ResponseWriter, status int, res any) {
+	w.Header().Set("Content-Type", responses.GeoJSONContentType)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		s.log.WithError(err).Error("failed to write geojson")
+	}
+}
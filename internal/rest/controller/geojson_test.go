@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWantsGeoJSON(t *testing.T) {
+	cases := []struct {
+		name   string
+		target string
+		accept string
+		want   bool
+	}{
+		{name: "no format", target: "/places", want: false},
+		{name: "format geojson", target: "/places?format=geojson", want: true},
+		{name: "accept header", target: "/places", accept: "application/geo+json", want: true},
+		{name: "accept list with params", target: "/places", accept: "application/json;q=0.9, application/geo+json; q=1", want: true},
+		{name: "format json wins over the header", target: "/places?format=json", accept: "application/geo+json", want: false},
+		{name: "other accept", target: "/places", accept: "application/vnd.api+json", want: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", c.target, nil)
+			if c.accept != "" {
+				r.Header.Set("Accept", c.accept)
+			}
+
+			w := httptest.NewRecorder()
+			got, err := wantsGeoJSON(w, r)
+			if err != nil {
+				t.Fatalf("wantsGeoJSON: %v", err)
+			}
+			if got != c.want {
+				t.Fatalf("expected %v, got %v", c.want, got)
+			}
+			if vary := w.Header().Get("Vary"); vary != "Accept" {
+				t.Fatalf("expected Vary: Accept on both representations, got %q", vary)
+			}
+		})
+	}
+
+	t.Run("unknown format", func(t *testing.T) {
+		r := httptest.NewRequest("GET", "/places?format=xml", nil)
+		r.Header.Set("Accept", "application/geo+json")
+
+		if _, err := wantsGeoJSON(httptest.NewRecorder(), r); err == nil {
+			t.Fatalf("expected an error for format=xml")
+		}
+	})
+}
@@ -25,6 +25,15 @@ func (s Service) GetPlace(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	geoJSON, err := wantsGeoJSON(w, r)
+	if err != nil {
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"format": err,
+		})...)
+
+		return
+	}
+
 	res, err := s.domain.place.Get(r.Context(), placeID, DetectLocale(w, r))
 	if err != nil {
 		s.log.WithError(err).WithField("place_id", placeID).Error("error getting place")
@@ -38,5 +47,17 @@ func (s Service) GetPlace(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if geoJSON {
+		feature, err := responses.PlaceFeature(res)
+		if err != nil {
+			s.log.WithError(err).WithField("place_id", placeID).Error("failed to build place geojson")
+			ape.RenderErr(w, problems.InternalError())
+			return
+		}
+
+		s.renderGeoJSON(w, http.StatusOK, feature)
+		return
+	}
+
 	ape.Render(w, http.StatusOK, responses.Place(res))
 }
@@ -0,0 +1,88 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/chains-lab/ape"
+	"github.com/chains-lab/ape/problems"
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/services/place"
+	"github.com/chains-lab/places-svc/internal/rest/responses"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func (s Service) GetPlaceClusters(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	filters, geo, err := placeFilters(q)
+	if err != nil {
+		ape.RenderErr(w, problems.BadRequest(err)...)
+		return
+	}
+	if filters.BBox == nil {
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"bbox": errors.New("the 'bbox' parameter is required"),
+		})...)
+
+		return
+	}
+	if err = checkClusterFilters(filters, geo); err != nil {
+		ape.RenderErr(w, problems.BadRequest(err)...)
+		return
+	}
+
+	zoom, err := strconv.ParseUint(strings.TrimSpace(q.Get("zoom")), 10, 8)
+	if err != nil || zoom > place.MaxClusterZoom {
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"zoom": fmt.Errorf("expected a number from 0 to %d, got %q", place.MaxClusterZoom, q.Get("zoom")),
+		})...)
+
+		return
+	}
+
+	res, err := s.domain.place.Clusters(r.Context(), filters, *filters.BBox, uint(zoom))
+	if err != nil {
+		s.log.WithError(err).Error("failed to cluster places")
+		switch {
+		case errors.Is(err, errx.ErrorInvalidSearchArea):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"bbox": err,
+			})...)
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.PlaceClusters(res))
+}
+
+// checkClusterFilters rejects the FilterPlace params place.Service.Clusters does not take into account,
+// clustering keeps only the class, status, verified, city, district and company filters.
+func checkClusterFilters(filters place.FilterParams, geo *place.FilterDistance) error {
+	unsupported := map[string]bool{
+		"name":         filters.Name != nil,
+		"address":      filters.Address != nil,
+		"country_code": filters.CountryCode != nil,
+		"postcode":     filters.Postcode != nil,
+		"street":       filters.Street != nil,
+		"q":            filters.Search != nil,
+		"point":        geo != nil,
+		"time_from":    filters.Time != nil,
+		"open_at":      filters.OpenAt != nil,
+	}
+
+	errs := validation.Errors{}
+	for param, set := range unsupported {
+		if set {
+			errs[param] = errors.New("is not supported when clustering places")
+		}
+	}
+
+	return errs.Filter()
+}
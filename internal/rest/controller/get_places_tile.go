@@ -0,0 +1,93 @@
+package controller
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/chains-lab/ape"
+	"github.com/chains-lab/ape/problems"
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	MVTContentType = "application/vnd.mapbox-vector-tile"
+
+	// tileMaxAge lets map clients reuse a tile for a while and revalidate it with If-None-Match afterwards.
+	tileMaxAge = 60
+)
+
+func (s Service) GetPlacesTile(w http.ResponseWriter, r *http.Request) {
+	var coords [3]uint32
+	for i, name := range []string{"z", "x", "y"} {
+		v, err := strconv.ParseUint(chi.URLParam(r, name), 10, 32)
+		if err != nil {
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				name: fmt.Errorf("invalid tile coordinate %q", chi.URLParam(r, name)),
+			})...)
+
+			return
+		}
+		coords[i] = uint32(v)
+	}
+
+	filters, _, err := placeFilters(r.URL.Query())
+	if err != nil {
+		ape.RenderErr(w, problems.BadRequest(err)...)
+		return
+	}
+
+	tile, err := s.domain.place.Tile(r.Context(), DetectLocale(w, r), filters, coords[0], coords[1], coords[2])
+	if err != nil {
+		s.log.WithError(err).Error("failed to render places tile")
+		switch {
+		case errors.Is(err, errx.ErrorInvalidTile):
+			ape.RenderErr(w, problems.NotFound("tile not found"))
+		case errors.Is(err, errx.ErrorInvalidSearchArea):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"bbox": err,
+			})...)
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	sum := sha256.Sum256(tile)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", tileMaxAge))
+	w.Header().Set("Vary", "Accept-Language")
+
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", MVTContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(tile)))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(tile); err != nil {
+		s.log.WithError(err).Error("failed to write places tile")
+	}
+}
+
+// etagMatches reports whether an If-None-Match header lists the etag, weak validators included.
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == etag {
+			return true
+		}
+	}
+
+	return false
+}
@@ -4,10 +4,14 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/chains-lab/ape"
 	"github.com/chains-lab/ape/problems"
 	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/chains-lab/places-svc/internal/rest/requests"
+	"github.com/chains-lab/places-svc/internal/rest/responses"
 	"github.com/go-chi/chi/v5"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/google/uuid"
@@ -24,17 +28,74 @@ func (s Service) GetTimetable(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	timetable, err := s.domain.timetable.GetForPlace(r.Context(), placeID)
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "json" && format != "osm" {
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"format": fmt.Errorf("unsupported format %q, expected 'json' or 'osm'", format),
+		})...)
+
+		return
+	}
+
+	var hour12 bool
+	summary := r.URL.Query().Get("summary")
+	switch summary {
+	case "":
+	case "24h":
+	case "12h":
+		hour12 = true
+	default:
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"summary": fmt.Errorf("unsupported summary clock %q, expected '24h' or '12h'", summary),
+		})...)
+
+		return
+	}
+	if summary != "" && format == "osm" {
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"summary": fmt.Errorf("summary is not available with format 'osm'"),
+		})...)
+
+		return
+	}
+
+	var timetable models.Timetable
+	if name := strings.TrimSpace(r.URL.Query().Get("name")); name != "" {
+		timetable, err = s.domain.timetable.GetNamedForPlace(r.Context(), placeID, name)
+	} else {
+		timetable, err = s.domain.timetable.GetForPlace(r.Context(), placeID)
+	}
 	if err != nil {
 		s.log.WithError(err).Error("failed to get timetable")
 		switch {
 		case errors.Is(err, errx.ErrorPlaceNotFound):
 			ape.RenderErr(w, problems.NotFound("place not found"))
+		case errors.Is(err, errx.ErrorTimetableNotFound):
+			ape.RenderErr(w, problems.NotFound("timetable not found"))
 		default:
 			ape.RenderErr(w, problems.InternalError())
 		}
 
 		return
 	}
-	ape.Render(w, http.StatusOK, timetable)
+
+	if format == "osm" {
+		// только недельная часть, исключения по датам в opening_hours не выгружаются
+		w.Header().Set("Content-Type", requests.OpeningHoursContentType+"; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		if _, err = w.Write([]byte(timetable.OpeningHours())); err != nil {
+			s.log.WithError(err).Error("failed to write opening_hours")
+		}
+
+		return
+	}
+
+	resp := responses.Timetable(timetable)
+	resp.Data.Id = placeID
+	if summary != "" {
+		text := timetable.Summary(DetectLocale(w, r), hour12)
+		resp.Data.Attributes.Summary = &text
+	}
+
+	ape.Render(w, http.StatusOK, resp)
 }
@@ -0,0 +1,68 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/chains-lab/ape"
+	"github.com/chains-lab/ape/problems"
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/services/timetable"
+	"github.com/chains-lab/places-svc/internal/rest/responses"
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+)
+
+func (s Service) GetTimetableExceptions(w http.ResponseWriter, r *http.Request) {
+	placeID, err := uuid.Parse(chi.URLParam(r, "place_id"))
+	if err != nil {
+		s.log.WithError(err).Error("invalid place_id")
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"query": fmt.Errorf("failed to parse place_id: %w", err),
+		})...)
+
+		return
+	}
+
+	filter := timetable.ExceptionsFilter{}
+	q := r.URL.Query()
+
+	if v := q.Get("from"); v != "" {
+		from, err := time.Parse(time.DateOnly, v)
+		if err != nil {
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"from": fmt.Errorf("invalid date format (YYYY-MM-DD): %w", err),
+			})...)
+			return
+		}
+		filter.From = &from
+	}
+	if v := q.Get("to"); v != "" {
+		to, err := time.Parse(time.DateOnly, v)
+		if err != nil {
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"to": fmt.Errorf("invalid date format (YYYY-MM-DD): %w", err),
+			})...)
+			return
+		}
+		filter.To = &to
+	}
+
+	exceptions, err := s.domain.timetable.GetExceptionsForPlace(r.Context(), placeID, filter)
+	if err != nil {
+		s.log.WithError(err).Error("failed to get timetable exceptions")
+		switch {
+		case errors.Is(err, errx.ErrorPlaceNotFound):
+			ape.RenderErr(w, problems.NotFound("place not found"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.TimetableExceptionsCollection(placeID, exceptions))
+}
@@ -0,0 +1,56 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/chains-lab/ape"
+	"github.com/chains-lab/ape/problems"
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/rest/responses"
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+)
+
+func (s Service) GetTimetableStatus(w http.ResponseWriter, r *http.Request) {
+	placeID, err := uuid.Parse(chi.URLParam(r, "place_id"))
+	if err != nil {
+		s.log.WithError(err).Error("invalid place_id")
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"query": fmt.Errorf("failed to parse place_id: %w", err),
+		})...)
+
+		return
+	}
+
+	at := time.Now().UTC()
+	if v := strings.TrimSpace(r.URL.Query().Get("at")); v != "" {
+		at, err = time.Parse(time.RFC3339, v)
+		if err != nil {
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"at": fmt.Errorf("expected RFC3339 timestamp, got %q", v),
+			})...)
+
+			return
+		}
+	}
+
+	status, err := s.domain.timetable.StatusForPlace(r.Context(), placeID, at)
+	if err != nil {
+		s.log.WithError(err).Error("failed to get timetable status")
+		switch {
+		case errors.Is(err, errx.ErrorPlaceNotFound):
+			ape.RenderErr(w, problems.NotFound("place not found"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.TimetableStatus(placeID, status))
+}
@@ -0,0 +1,17 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/chains-lab/ape"
+	"github.com/chains-lab/places-svc/internal/rest/responses"
+)
+
+func (s Service) GetTimetableTemplate(w http.ResponseWriter, r *http.Request) {
+	template, ok := s.companyTemplate(w, r, nil)
+	if !ok {
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.TimetableTemplate(template))
+}
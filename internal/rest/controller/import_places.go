@@ -0,0 +1,122 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/chains-lab/ape"
+	"github.com/chains-lab/ape/problems"
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/services/pimport"
+	"github.com/chains-lab/places-svc/internal/rest/meta"
+	"github.com/chains-lab/places-svc/internal/rest/responses"
+	"github.com/chains-lab/restkit/roles"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+)
+
+// MaxImportBodySize limits the size of an uploaded import file.
+const MaxImportBodySize = 16 << 20
+
+// роли в компании, которым можно импортировать места своей компании (как companyAdmin в роутере)
+var importCompanyRoles = map[string]bool{"owner": true, "admin": true}
+
+func (s Service) ImportPlaces(w http.ResponseWriter, r *http.Request) {
+	initiator, err := meta.User(r.Context())
+	if err != nil {
+		s.log.WithError(err).Error("failed to get user from context")
+		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
+
+		return
+	}
+
+	params := pimport.Params{}
+	if initiator.Role != roles.Admin {
+		companyID, ok := s.userCompany(w, r, importCompanyRoles)
+		if !ok {
+			return
+		}
+		params.CompanyID = &companyID
+	}
+
+	if dryRun := strings.TrimSpace(r.URL.Query().Get("dry_run")); dryRun != "" {
+		params.DryRun, err = parseBoolParam(dryRun)
+		if err != nil {
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"dry_run": err,
+			})...)
+
+			return
+		}
+	}
+
+	format, err := importFormat(r)
+	if err != nil {
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"format": err,
+		})...)
+
+		return
+	}
+
+	rows, err := pimport.Decode(http.MaxBytesReader(w, r.Body, MaxImportBodySize), format)
+	if err != nil {
+		s.log.WithError(err).Error("failed to decode places import")
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"body": err,
+		})...)
+
+		return
+	}
+
+	report, err := s.domain.pimport.Import(r.Context(), rows, params)
+	if err != nil {
+		s.log.WithError(err).Error("failed to import places")
+		switch {
+		case errors.Is(err, errx.ErrorInvalidPlacesImport):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"body": err,
+			})...)
+		case errors.Is(err, errx.ErrorPlaceOfAnotherCompany):
+			ape.RenderErr(w, problems.Forbidden("place belongs to another company"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	importID := uuid.New()
+	s.log.Infof("places import %s by user %s: %d created, %d updated, %d failed, dry run %t",
+		importID, initiator.ID, report.Created, report.Updated, report.Failed, report.DryRun)
+
+	ape.Render(w, http.StatusOK, responses.PlacesImport(importID, report))
+}
+
+// importFormat picks the format of the uploaded file from the format param or the Content-Type header.
+func importFormat(r *http.Request) (string, error) {
+	switch format := r.URL.Query().Get("format"); format {
+	case pimport.FormatCSV, pimport.FormatGeoJSON:
+		return format, nil
+	case "":
+	default:
+		return "", fmt.Errorf("unsupported format %q, expected 'csv' or 'geojson'", format)
+	}
+
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return "", errors.New("the 'format' parameter or the Content-Type header is required")
+	}
+
+	switch mediaType {
+	case "text/csv":
+		return pimport.FormatCSV, nil
+	case responses.GeoJSONContentType, "application/json":
+		return pimport.FormatGeoJSON, nil
+	default:
+		return "", fmt.Errorf("unsupported content type %q, expected text/csv or %s", mediaType, responses.GeoJSONContentType)
+	}
+}
@@ -0,0 +1,62 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/chains-lab/ape"
+	"github.com/chains-lab/ape/problems"
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/chains-lab/places-svc/internal/domain/services/timetable"
+	"github.com/chains-lab/places-svc/internal/rest/requests"
+	"github.com/chains-lab/places-svc/internal/rest/responses"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func (s Service) LinkTimetableTemplate(w http.ResponseWriter, r *http.Request) {
+	req, err := requests.LinkTimetableTemplate(r)
+	if err != nil {
+		s.log.WithError(err).Error("invalid request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+		return
+	}
+
+	var validity models.Timetable
+	if err = setTimetableValidity(&validity, req.Data.Attributes.Name,
+		req.Data.Attributes.ValidFrom, req.Data.Attributes.ValidTo); err != nil {
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"data/attributes": err,
+		})...)
+		return
+	}
+
+	res, err := s.domain.timetable.LinkTemplateForPlace(r.Context(), req.Data.Id, DetectLocale(w, r), timetable.LinkTemplateParams{
+		TemplateID: req.Data.Attributes.TemplateId,
+		Name:       validity.Name,
+		ValidFrom:  validity.ValidFrom,
+		ValidTo:    validity.ValidTo,
+	})
+	if err != nil {
+		s.log.WithError(err).Error("could not link timetable template")
+		switch {
+		case errors.Is(err, errx.ErrorPlaceNotFound):
+			ape.RenderErr(w, problems.NotFound(fmt.Sprintf("place %s not found", req.Data.Id)))
+		case errors.Is(err, errx.ErrorTimetableTemplateNotFound):
+			ape.RenderErr(w, problems.NotFound("timetable template not found"))
+		case errors.Is(err, errx.ErrorTimetableTemplateOfOtherCompany):
+			ape.RenderErr(w, problems.Forbidden("timetable template belongs to another company"))
+		case errors.Is(err, errx.ErrorInvalidTimetable):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"data/attributes": err,
+			})...)
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.Place(res))
+}
@@ -0,0 +1,22 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/chains-lab/ape"
+	"github.com/chains-lab/ape/problems"
+	"github.com/chains-lab/places-svc/internal/rest/requests"
+)
+
+// SearchPlaces is FilterPlace limited to an area drawn on the map, too large to fit into the query.
+func (s Service) SearchPlaces(w http.ResponseWriter, r *http.Request) {
+	area, err := requests.SearchPlaces(r)
+	if err != nil {
+		s.log.WithError(err).Error("invalid search places request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+
+		return
+	}
+
+	s.filterPlaces(w, r, area)
+}
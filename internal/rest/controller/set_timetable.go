@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
-	"sort"
 	"strings"
 	"time"
 
@@ -15,7 +14,10 @@ import (
 	"github.com/chains-lab/places-svc/internal/domain/models"
 	"github.com/chains-lab/places-svc/internal/rest/requests"
 	"github.com/chains-lab/places-svc/internal/rest/responses"
+	"github.com/chains-lab/places-svc/resources"
+	"github.com/go-chi/chi/v5"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
 )
 
 var hhmmRe = regexp.MustCompile(`^(?:[01]\d|2[0-3]):[0-5]\d$`)
@@ -47,111 +49,160 @@ func parseHHMM(s string) (time.Duration, error) {
 	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute, nil
 }
 
-type daySpan struct{ start, end int }
+func (s Service) SetTimetable(w http.ResponseWriter, r *http.Request) {
+	if requests.IsOpeningHours(r) {
+		s.setTimetableOpeningHours(w, r)
+		return
+	}
 
-func validateNoOverlaps(spans []daySpan) error {
-	if len(spans) <= 1 {
-		return nil
+	req, err := requests.SetTimetable(r)
+	if err != nil {
+		s.log.WithError(err).Error("invalid request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+		return
 	}
-	sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
-	prev := spans[0]
-	for i := 1; i < len(spans); i++ {
-		cur := spans[i]
-		if cur.start < prev.end {
-			return fmt.Errorf("overlap: [%02d:%02d-%02d:%02d) with [%02d:%02d-%02d:%02d)",
-				prev.start/60, prev.start%60, prev.end/60, prev.end%60,
-				cur.start/60, cur.start%60, cur.end/60, cur.end%60)
-		}
-		prev = cur
+
+	var params models.Timetable
+	if err = setTimetableValidity(&params, req.Data.Attributes.Name,
+		req.Data.Attributes.ValidFrom, req.Data.Attributes.ValidTo); err != nil {
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"data/attributes": err,
+		})...)
+		return
 	}
-	return nil
+
+	params.Table, err = parseTimetableTable(req.Data.Attributes.Table)
+	if err != nil {
+		ape.RenderErr(w, problems.BadRequest(err)...)
+		return
+	}
+
+	s.setTimetable(w, r, req.Data.Id, params)
 }
 
-func (s Service) SetTimetable(w http.ResponseWriter, r *http.Request) {
-	req, err := requests.SetTimetable(r)
+// setTimetableOpeningHours handles PUT with the body in OSM opening_hours syntax,
+// the place is taken from the URL.
+func (s Service) setTimetableOpeningHours(w http.ResponseWriter, r *http.Request) {
+	placeID, err := uuid.Parse(chi.URLParam(r, "place_id"))
+	if err != nil {
+		s.log.WithError(err).Error("invalid place_id")
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"query": fmt.Errorf("failed to parse place_id: %w", err),
+		})...)
+
+		return
+	}
+
+	req, err := requests.SetTimetableOpeningHours(r)
 	if err != nil {
 		s.log.WithError(err).Error("invalid request")
 		ape.RenderErr(w, problems.BadRequest(err)...)
 		return
 	}
 
-	params := models.Timetable{Table: make([]models.TimeInterval, 0, len(req.Data.Attributes.Table))}
-	perDay := map[time.Weekday][]daySpan{}
+	params, err := models.ParseOpeningHours(req.OpeningHours)
+	if err != nil {
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"body": err,
+		})...)
+		return
+	}
+	if err = setTimetableValidity(&params, req.Name, req.ValidFrom, req.ValidTo); err != nil {
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"query": err,
+		})...)
+		return
+	}
+
+	s.setTimetable(w, r, placeID, params)
+}
+
+func (s Service) setTimetable(w http.ResponseWriter, r *http.Request, placeID uuid.UUID, params models.Timetable) {
+	res, err := s.domain.timetable.SetForPlace(r.Context(), placeID, DetectLocale(w, r), params)
+	if err != nil {
+		s.log.WithError(err).Error("could not set timetable")
+		switch {
+		case errors.Is(err, errx.ErrorPlaceNotFound):
+			ape.RenderErr(w, problems.NotFound(fmt.Sprintf("place %s not found", placeID)))
+		case errors.Is(err, errx.ErrorInvalidTimetable):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"data/attributes/table": err,
+			})...)
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.Place(res))
+}
 
-	for i, interval := range req.Data.Attributes.Table {
+// parseTimetableTable converts request intervals to the model, errors are keyed by the JSON path of the field.
+func parseTimetableTable(table []resources.TimetableInterval) ([]models.TimeInterval, error) {
+	res := make([]models.TimeInterval, 0, len(table))
+	for i, interval := range table {
 		fromWD, err := parseWeekday(interval.From.Weekday)
 		if err != nil {
-			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			return nil, validation.Errors{
 				fmt.Sprintf("data/attributes/table/%d/from/weekday", i): err,
-			})...)
-			return
+			}
 		}
 		toWD, err := parseWeekday(interval.To.Weekday)
 		if err != nil {
-			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			return nil, validation.Errors{
 				fmt.Sprintf("data/attributes/table/%d/to/weekday", i): err,
-			})...)
-			return
+			}
 		}
 		fromT, err := parseHHMM(interval.From.Time)
 		if err != nil {
-			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			return nil, validation.Errors{
 				fmt.Sprintf("data/attributes/table/%d/from/time", i): err,
-			})...)
-			return
+			}
 		}
 		toT, err := parseHHMM(interval.To.Time)
 		if err != nil {
-			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			return nil, validation.Errors{
 				fmt.Sprintf("data/attributes/table/%d/to/time", i): err,
-			})...)
-			return
+			}
 		}
 
-		if fromWD != toWD {
-			ape.RenderErr(w, problems.BadRequest(validation.Errors{
-				fmt.Sprintf("data/attributes/table/%d", i): fmt.Errorf("from.weekday and to.weekday must be the same", i),
-			})...)
-			return
-		}
-		if !(fromT < toT) {
-			ape.RenderErr(w, problems.BadRequest(validation.Errors{
-				fmt.Sprintf("data/attributes/table/%d", i): fmt.Errorf("from.time must be less than to.time", i),
-			})...)
-			return
+		// интервал может переходить через полночь и через конец недели (сб → вс),
+		// пересечения проверяет сервис timetable
+		if fromWD == toWD && fromT == toT {
+			return nil, validation.Errors{
+				fmt.Sprintf("data/attributes/table/%d", i): fmt.Errorf("interval must not be empty"),
+			}
 		}
 
-		startMin := int(fromT / time.Minute)
-		endMin := int(toT / time.Minute)
-		perDay[fromWD] = append(perDay[fromWD], daySpan{start: startMin, end: endMin})
-
-		params.Table = append(params.Table, models.TimeInterval{
+		res = append(res, models.TimeInterval{
 			From: models.Moment{Weekday: fromWD, Time: fromT},
 			To:   models.Moment{Weekday: toWD, Time: toT},
 		})
 	}
 
-	for wd, spans := range perDay {
-		if err := validateNoOverlaps(spans); err != nil {
-			ape.RenderErr(w, problems.BadRequest(validation.Errors{
-				"data/attributes/table": fmt.Errorf("overlapping intervals for weekday %q: %w", wd.String(), err),
-			})...)
-			return
+	return res, nil
+}
+
+// setTimetableValidity copies the optional name and YYYY-MM-DD validity dates into params.
+func setTimetableValidity(params *models.Timetable, name, validFrom, validTo *string) error {
+	if name != nil {
+		params.Name = *name
+	}
+	if validFrom != nil {
+		from, err := time.Parse(time.DateOnly, *validFrom)
+		if err != nil {
+			return fmt.Errorf("invalid valid_from: %w", err)
 		}
+		params.ValidFrom = &from
 	}
-
-	res, err := s.domain.timetable.SetForPlace(r.Context(), req.Data.Id, DetectLocale(w, r), params)
-	if err != nil {
-		s.log.WithError(err).Error("could not set timetable")
-		switch {
-		case errors.Is(err, errx.ErrorPlaceNotFound):
-			ape.RenderErr(w, problems.NotFound(fmt.Sprintf("place %s not found", req.Data.Id)))
-		default:
-			ape.RenderErr(w, problems.InternalError())
+	if validTo != nil {
+		to, err := time.Parse(time.DateOnly, *validTo)
+		if err != nil {
+			return fmt.Errorf("invalid valid_to: %w", err)
 		}
-
-		return
+		params.ValidTo = &to
 	}
 
-	ape.Render(w, http.StatusOK, responses.Place(res))
+	return nil
 }
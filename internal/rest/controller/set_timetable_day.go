@@ -0,0 +1,80 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/chains-lab/ape"
+	"github.com/chains-lab/ape/problems"
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/chains-lab/places-svc/internal/domain/services/timetable"
+	"github.com/chains-lab/places-svc/internal/rest/requests"
+	"github.com/chains-lab/places-svc/internal/rest/responses"
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func (s Service) SetTimetableDay(w http.ResponseWriter, r *http.Request) {
+	weekday, err := parseWeekday(chi.URLParam(r, "weekday"))
+	if err != nil {
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"weekday": err,
+		})...)
+		return
+	}
+
+	req, err := requests.SetTimetableDay(r)
+	if err != nil {
+		s.log.WithError(err).Error("invalid request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+		return
+	}
+
+	params := timetable.DayParams{
+		Weekday:   weekday,
+		Intervals: make([]models.DayInterval, 0, len(req.Data.Attributes.Intervals)),
+	}
+	if req.Data.Attributes.Name != nil {
+		params.Name = *req.Data.Attributes.Name
+	}
+
+	for i, interval := range req.Data.Attributes.Intervals {
+		from, err := parseHHMM(interval.From)
+		if err != nil {
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				fmt.Sprintf("data/attributes/intervals/%d/from", i): err,
+			})...)
+			return
+		}
+		to, err := parseDayEnd(interval.To)
+		if err != nil {
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				fmt.Sprintf("data/attributes/intervals/%d/to", i): err,
+			})...)
+			return
+		}
+
+		params.Intervals = append(params.Intervals, models.DayInterval{From: from, To: to})
+	}
+
+	res, err := s.domain.timetable.SetDayForPlace(r.Context(), req.Data.Id, DetectLocale(w, r), params)
+	if err != nil {
+		s.log.WithError(err).Error("could not set timetable day")
+		switch {
+		case errors.Is(err, errx.ErrorPlaceNotFound):
+			ape.RenderErr(w, problems.NotFound(fmt.Sprintf("place %s not found", req.Data.Id)))
+		case errors.Is(err, errx.ErrorInvalidTimetable):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"data/attributes/intervals": err,
+			})...)
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.Place(res))
+}
@@ -0,0 +1,85 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/chains-lab/ape"
+	"github.com/chains-lab/ape/problems"
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/chains-lab/places-svc/internal/rest/requests"
+	"github.com/chains-lab/places-svc/internal/rest/responses"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// parseDayEnd accepts HH:MM like parseHHMM and additionally "24:00" as the end of the day.
+func parseDayEnd(s string) (time.Duration, error) {
+	if s == "24:00" {
+		return 24 * time.Hour, nil
+	}
+	return parseHHMM(s)
+}
+
+func (s Service) SetTimetableException(w http.ResponseWriter, r *http.Request) {
+	req, err := requests.SetTimetableException(r)
+	if err != nil {
+		s.log.WithError(err).Error("invalid request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+		return
+	}
+
+	date, err := time.Parse(time.DateOnly, req.Data.Attributes.Date)
+	if err != nil {
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"data/attributes/date": fmt.Errorf("invalid date format (YYYY-MM-DD): %w", err),
+		})...)
+		return
+	}
+
+	params := models.TimetableException{
+		Date:      date,
+		Reason:    req.Data.Attributes.Reason,
+		Intervals: make([]models.DayInterval, 0, len(req.Data.Attributes.Intervals)),
+	}
+
+	for i, interval := range req.Data.Attributes.Intervals {
+		from, err := parseHHMM(interval.From)
+		if err != nil {
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				fmt.Sprintf("data/attributes/intervals/%d/from", i): err,
+			})...)
+			return
+		}
+		to, err := parseDayEnd(interval.To)
+		if err != nil {
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				fmt.Sprintf("data/attributes/intervals/%d/to", i): err,
+			})...)
+			return
+		}
+
+		params.Intervals = append(params.Intervals, models.DayInterval{From: from, To: to})
+	}
+
+	res, err := s.domain.timetable.SetExceptionForPlace(r.Context(), req.Data.Id, params)
+	if err != nil {
+		s.log.WithError(err).Error("could not set timetable exception")
+		switch {
+		case errors.Is(err, errx.ErrorPlaceNotFound):
+			ape.RenderErr(w, problems.NotFound(fmt.Sprintf("place %s not found", req.Data.Id)))
+		case errors.Is(err, errx.ErrorInvalidTimetableException):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"data/attributes/intervals": err,
+			})...)
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.TimetableException(req.Data.Id, res))
+}
@@ -0,0 +1,81 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/chains-lab/ape"
+	"github.com/chains-lab/ape/problems"
+	"github.com/chains-lab/places-svc/internal/domain/services/place"
+	"github.com/chains-lab/places-svc/internal/rest/responses"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/paulmach/orb"
+)
+
+func (s Service) SuggestPlaces(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+
+	params := place.SuggestParams{Query: strings.TrimSpace(q.Get("q"))}
+	if params.Query == "" {
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"q": errors.New("the 'q' parameter is required"),
+		})...)
+
+		return
+	}
+
+	if point := strings.TrimSpace(q.Get("point")); point != "" {
+		pt, err := parsePointParam(point)
+		if err != nil {
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"point": err,
+			})...)
+
+			return
+		}
+		params.Point = &pt
+	}
+
+	if limit := strings.TrimSpace(q.Get("limit")); limit != "" {
+		l, err := strconv.ParseUint(limit, 10, 64)
+		if err != nil || l == 0 || l > place.MaxSuggestLimit {
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"limit": fmt.Errorf("expected a number from 1 to %d, got %q", place.MaxSuggestLimit, limit),
+			})...)
+
+			return
+		}
+		params.Limit = l
+	}
+
+	res, err := s.domain.place.Suggest(r.Context(), DetectLocale(w, r), params)
+	if err != nil {
+		s.log.WithError(err).Error("failed to suggest places")
+		ape.RenderErr(w, problems.InternalError())
+
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.PlaceSuggestions(res))
+}
+
+func parsePointParam(v string) (orb.Point, error) {
+	parts := strings.Split(v, ",")
+	if len(parts) != 2 {
+		return orb.Point{}, fmt.Errorf("expected 'lon,lat', got %q", v)
+	}
+
+	lon, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil || lon < -180 || lon > 180 {
+		return orb.Point{}, fmt.Errorf("invalid longitude value: %q", parts[0])
+	}
+	lat, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if err != nil || lat < -90 || lat > 90 {
+		return orb.Point{}, fmt.Errorf("invalid latitude value: %q", parts[1])
+	}
+
+	return orb.Point{lon, lat}, nil
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/chains-lab/places-svc/internal/domain/services/place"
 	"github.com/chains-lab/places-svc/internal/rest/requests"
 	"github.com/chains-lab/places-svc/internal/rest/responses"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/paulmach/orb"
 )
 
 func (s Service) UpdatePlace(w http.ResponseWriter, r *http.Request) {
@@ -33,6 +35,18 @@ func (s Service) UpdatePlace(w http.ResponseWriter, r *http.Request) {
 	if req.Data.Attributes.Class != nil {
 		params.Class = req.Data.Attributes.Class
 	}
+	if req.Data.Attributes.Timezone != nil {
+		params.Timezone = req.Data.Attributes.Timezone
+	}
+	if req.Data.Attributes.Point != nil {
+		params.Point = &orb.Point{
+			req.Data.Attributes.Point.Lon,
+			req.Data.Attributes.Point.Lat,
+		}
+	}
+	if req.Data.Attributes.Address != nil {
+		params.Address = req.Data.Attributes.Address
+	}
 
 	res, err := s.domain.place.Update(
 		r.Context(),
@@ -45,8 +59,18 @@ func (s Service) UpdatePlace(w http.ResponseWriter, r *http.Request) {
 		switch {
 		case errors.Is(err, errx.ErrorPlaceNotFound):
 			ape.RenderErr(w, problems.NotFound(fmt.Sprintf("place %s not found", req.Data.Id)))
+		case errors.Is(err, errx.ErrorAddressNotFound), errors.Is(err, errx.ErrorAddressAmbiguous):
+			renderAddressErr(w, err)
 		case errors.Is(err, errx.ErrorClassNotFound):
 			ape.RenderErr(w, problems.NotFound(fmt.Sprintf("class %s not found", *params.Class)))
+		case errors.Is(err, errx.ErrorInvalidTimezone):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"data/attributes/timezone": err,
+			})...)
+		case errors.Is(err, errx.ErrorPlaceOutsideCity):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"data/attributes/point": err,
+			})...)
 		default:
 			ape.RenderErr(w, problems.InternalError())
 		}
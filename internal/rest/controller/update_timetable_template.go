@@ -0,0 +1,61 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/chains-lab/ape"
+	"github.com/chains-lab/ape/problems"
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/services/ttemplate"
+	"github.com/chains-lab/places-svc/internal/rest/requests"
+	"github.com/chains-lab/places-svc/internal/rest/responses"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func (s Service) UpdateTimetableTemplate(w http.ResponseWriter, r *http.Request) {
+	template, ok := s.companyTemplate(w, r, templateModerRoles)
+	if !ok {
+		return
+	}
+
+	req, err := requests.UpdateTimetableTemplate(r)
+	if err != nil {
+		s.log.WithError(err).Error("invalid request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+		return
+	}
+
+	params := ttemplate.UpdateParams{
+		Name: req.Data.Attributes.Name,
+	}
+	if req.Data.Attributes.Table != nil {
+		table, err := parseTimetableTable(req.Data.Attributes.Table)
+		if err != nil {
+			ape.RenderErr(w, problems.BadRequest(err)...)
+			return
+		}
+		params.Table = &table
+	}
+
+	res, err := s.domain.ttemplate.Update(r.Context(), template.ID, params)
+	if err != nil {
+		s.log.WithError(err).Error("could not update timetable template")
+		switch {
+		case errors.Is(err, errx.ErrorTimetableTemplateNotFound):
+			ape.RenderErr(w, problems.NotFound("timetable template not found"))
+		case errors.Is(err, errx.ErrorInvalidTimetable):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"data/attributes/table": err,
+			})...)
+		case errors.Is(err, errx.ErrorTimetableTemplateNameAlreadyTaken):
+			ape.RenderErr(w, problems.Conflict("timetable template with this name already exists"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.TimetableTemplate(res))
+}
@@ -36,6 +36,13 @@ func CreatePlace(r *http.Request) (req resources.CreatePlace, err error) {
 			req.Data.Attributes.Website, validation.Length(0, 255)),
 		"data/attributes/phone": validation.Validate(
 			req.Data.Attributes.Phone, validation.Length(0, 32)),
+		"data/attributes/timezone": validation.Validate(
+			req.Data.Attributes.Timezone, validation.Length(1, 64)),
+		"data/attributes/address": validation.Validate(
+			req.Data.Attributes.Address, validation.Length(1, 255)),
+	}
+	if req.Data.Attributes.Point == nil && req.Data.Attributes.Address == nil {
+		errs["data/attributes/point"] = fmt.Errorf("either point or address is required")
 	}
 
 	return req, errs.Filter()
@@ -0,0 +1,26 @@
+package requests
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/chains-lab/places-svc/resources"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func CreateTimetableTemplate(r *http.Request) (req resources.CreateTimetableTemplate, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = newDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type":       validation.Validate(req.Data.Type, validation.Required, validation.In(resources.TimetableTemplateType)),
+		"data/attributes": validation.Validate(req.Data.Attributes, validation.Required),
+		"data/attributes/name": validation.Validate(
+			req.Data.Attributes.Name, validation.Required, validation.Length(1, 64)),
+		"data/attributes/table": validation.Validate(req.Data.Attributes.Table, validation.NotNil),
+	}
+
+	return req, errs.Filter()
+}
@@ -0,0 +1,39 @@
+package requests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/chains-lab/places-svc/resources"
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+)
+
+func LinkTimetableTemplate(r *http.Request) (req resources.LinkTimetableTemplate, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = newDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/id":   validation.Validate(req.Data.Id, validation.Required, is.UUID),
+		"data/type": validation.Validate(req.Data.Type, validation.Required, validation.In(resources.TimetableType)),
+		"data/attributes/template_id": validation.Validate(
+			req.Data.Attributes.TemplateId, validation.Required, is.UUID),
+		"data/attributes/name": validation.Validate(
+			req.Data.Attributes.Name, validation.Length(1, 64), validation.Match(timetableNameRe)),
+		"data/attributes/valid_from": validation.Validate(
+			req.Data.Attributes.ValidFrom, validation.Date(time.DateOnly)),
+		"data/attributes/valid_to": validation.Validate(
+			req.Data.Attributes.ValidTo, validation.Date(time.DateOnly)),
+	}
+
+	if chi.URLParam(r, "place_id") != req.Data.Id.String() {
+		errs["data/id"] = fmt.Errorf("query place_id param and body data/id do not match")
+	}
+
+	return req, errs.Filter()
+}
@@ -0,0 +1,55 @@
+package requests
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/chains-lab/places-svc/resources"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/encoding/wkt"
+	"github.com/paulmach/orb/geojson"
+)
+
+// maxSearchPlacesBody keeps drawn areas reasonably small, the vertex count is checked by the domain.
+const maxSearchPlacesBody = 1 << 20
+
+// SearchPlaces decodes the area of a POST places search, given either as a GeoJSON geometry or as WKT.
+func SearchPlaces(r *http.Request) (area orb.Geometry, err error) {
+	var req resources.SearchPlaces
+	if err = json.NewDecoder(http.MaxBytesReader(nil, r.Body, maxSearchPlacesBody)).Decode(&req); err != nil {
+		err = newDecodeError("body", err)
+		return
+	}
+
+	attrs := req.Data.Attributes
+	errs := validation.Errors{
+		"data/type": validation.Validate(req.Data.Type, validation.Required, validation.In(resources.PlacesSearchType)),
+	}
+
+	switch {
+	case attrs.Geometry != nil && attrs.Wkt != nil:
+		errs["data/attributes"] = errors.New("only one of geometry and wkt may be provided")
+	case attrs.Geometry != nil:
+		raw, _ := json.Marshal(attrs.Geometry)
+		g, gErr := geojson.UnmarshalGeometry(raw)
+		if gErr != nil {
+			errs["data/attributes/geometry"] = fmt.Errorf("invalid GeoJSON geometry: %w", gErr)
+			break
+		}
+		area = g.Geometry()
+	case attrs.Wkt != nil:
+		g, wErr := wkt.Unmarshal(*attrs.Wkt)
+		if wErr != nil {
+			errs["data/attributes/wkt"] = fmt.Errorf("invalid WKT: %w", wErr)
+			break
+		}
+		area = g
+	default:
+		errs["data/attributes"] = errors.New("one of geometry and wkt is required")
+	}
+
+	return area, errs.Filter()
+}
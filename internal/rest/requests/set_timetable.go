@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"regexp"
+	"time"
 
 	"github.com/chains-lab/places-svc/resources"
 	"github.com/go-chi/chi/v5"
@@ -11,6 +13,8 @@ import (
 	"github.com/go-ozzo/ozzo-validation/v4/is"
 )
 
+var timetableNameRe = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
 func SetTimetable(r *http.Request) (req resources.SetPlaceTimetable, err error) {
 	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
 		err = newDecodeError("body", err)
@@ -21,6 +25,12 @@ func SetTimetable(r *http.Request) (req resources.SetPlaceTimetable, err error)
 		"data/id":         validation.Validate(req.Data.Id, validation.Required, is.UUID),
 		"data/type":       validation.Validate(req.Data.Type, validation.Required, validation.In(resources.PlaceType)),
 		"data/attributes": validation.Validate(req.Data.Attributes, validation.Required),
+		"data/attributes/name": validation.Validate(
+			req.Data.Attributes.Name, validation.Length(1, 64), validation.Match(timetableNameRe)),
+		"data/attributes/valid_from": validation.Validate(
+			req.Data.Attributes.ValidFrom, validation.Date(time.DateOnly)),
+		"data/attributes/valid_to": validation.Validate(
+			req.Data.Attributes.ValidTo, validation.Date(time.DateOnly)),
 	}
 
 	if chi.URLParam(r, "place_id") != req.Data.Id.String() {
@@ -0,0 +1,40 @@
+package requests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/chains-lab/places-svc/resources"
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+)
+
+func SetTimetableDay(r *http.Request) (req resources.SetTimetableDay, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = newDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/id":   validation.Validate(req.Data.Id, validation.Required, is.UUID),
+		"data/type": validation.Validate(req.Data.Type, validation.Required, validation.In(resources.TimetableType)),
+		"data/attributes/name": validation.Validate(
+			req.Data.Attributes.Name, validation.Length(1, 64), validation.Match(timetableNameRe)),
+		"data/attributes/intervals": validation.Validate(req.Data.Attributes.Intervals, validation.NotNil),
+	}
+
+	for i, interval := range req.Data.Attributes.Intervals {
+		errs[fmt.Sprintf("data/attributes/intervals/%d/from", i)] = validation.Validate(
+			interval.From, validation.Required, validation.Match(rangeFromRe))
+		errs[fmt.Sprintf("data/attributes/intervals/%d/to", i)] = validation.Validate(
+			interval.To, validation.Required, validation.Match(rangeToRe))
+	}
+
+	if chi.URLParam(r, "place_id") != req.Data.Id.String() {
+		errs["data/id"] = fmt.Errorf("query place_id param and body data/id do not match")
+	}
+
+	return req, errs.Filter()
+}
@@ -0,0 +1,48 @@
+package requests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/chains-lab/places-svc/resources"
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+)
+
+var (
+	rangeFromRe = regexp.MustCompile(`^(?:[01]\d|2[0-3]):[0-5]\d$`)
+	rangeToRe   = regexp.MustCompile(`^(?:(?:[01]\d|2[0-3]):[0-5]\d|24:00)$`)
+)
+
+func SetTimetableException(r *http.Request) (req resources.SetTimetableException, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = newDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/id":   validation.Validate(req.Data.Id, validation.Required, is.UUID),
+		"data/type": validation.Validate(req.Data.Type, validation.Required, validation.In(resources.TimetableExceptionType)),
+		"data/attributes/date": validation.Validate(
+			req.Data.Attributes.Date, validation.Required, validation.Date(time.DateOnly)),
+		"data/attributes/reason": validation.Validate(
+			req.Data.Attributes.Reason, validation.Length(0, 255)),
+	}
+
+	for i, interval := range req.Data.Attributes.Intervals {
+		errs[fmt.Sprintf("data/attributes/intervals/%d/from", i)] = validation.Validate(
+			interval.From, validation.Required, validation.Match(rangeFromRe))
+		errs[fmt.Sprintf("data/attributes/intervals/%d/to", i)] = validation.Validate(
+			interval.To, validation.Required, validation.Match(rangeToRe))
+	}
+
+	if chi.URLParam(r, "place_id") != req.Data.Id.String() {
+		errs["data/id"] = fmt.Errorf("query place_id param and body data/id do not match")
+	}
+
+	return req, errs.Filter()
+}
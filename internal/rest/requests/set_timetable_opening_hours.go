@@ -0,0 +1,69 @@
+package requests
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// OpeningHoursContentType is the media type of a timetable written in OSM opening_hours syntax,
+// e.g. "Mo-Fr 09:00-18:00; Sa 10:00-14:00; PH off".
+const OpeningHoursContentType = "text/vnd.osm.opening-hours"
+
+const maxOpeningHoursLen = 4096
+
+// IsOpeningHours reports whether the request body is sent as OSM opening_hours.
+func IsOpeningHours(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return err == nil && mediaType == OpeningHoursContentType
+}
+
+// OpeningHoursTimetable is a timetable sent as OSM opening_hours, the name and validity dates
+// of the timetable come in the query (?name=summer&valid_from=2025-06-01&valid_to=2025-09-30).
+type OpeningHoursTimetable struct {
+	OpeningHours string
+	Name         *string
+	ValidFrom    *string
+	ValidTo      *string
+}
+
+func SetTimetableOpeningHours(r *http.Request) (req OpeningHoursTimetable, err error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxOpeningHoursLen+1))
+	if err != nil {
+		err = newDecodeError("body", err)
+		return
+	}
+
+	q := r.URL.Query()
+	optional := func(key string) *string {
+		if v := strings.TrimSpace(q.Get(key)); v != "" {
+			return &v
+		}
+		return nil
+	}
+
+	req = OpeningHoursTimetable{
+		OpeningHours: strings.TrimSpace(string(body)),
+		Name:         optional("name"),
+		ValidFrom:    optional("valid_from"),
+		ValidTo:      optional("valid_to"),
+	}
+
+	errs := validation.Errors{
+		"body": validation.Validate(req.OpeningHours, validation.Required),
+		"name": validation.Validate(
+			req.Name, validation.Length(1, 64), validation.Match(timetableNameRe)),
+		"valid_from": validation.Validate(req.ValidFrom, validation.Date(time.DateOnly)),
+		"valid_to":   validation.Validate(req.ValidTo, validation.Date(time.DateOnly)),
+	}
+	if len(body) > maxOpeningHoursLen {
+		errs["body"] = fmt.Errorf("opening_hours must be at most %d bytes", maxOpeningHoursLen)
+	}
+
+	return req, errs.Filter()
+}
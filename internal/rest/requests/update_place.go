@@ -26,6 +26,10 @@ func UpdatePlace(r *http.Request) (req resources.UpdatePlace, err error) {
 			req.Data.Attributes.Website, validation.Length(0, 255)),
 		"data/attributes/phone": validation.Validate(
 			req.Data.Attributes.Phone, validation.Length(0, 32)),
+		"data/attributes/timezone": validation.Validate(
+			req.Data.Attributes.Timezone, validation.Length(1, 64)),
+		"data/attributes/address": validation.Validate(
+			req.Data.Attributes.Address, validation.Length(1, 255)),
 	}
 
 	if chi.URLParam(r, "place_id") != req.Data.Id.String() {
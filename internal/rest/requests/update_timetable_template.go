@@ -0,0 +1,31 @@
+package requests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/chains-lab/places-svc/resources"
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+)
+
+func UpdateTimetableTemplate(r *http.Request) (req resources.UpdateTimetableTemplate, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = newDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/id":              validation.Validate(req.Data.Id, validation.Required, is.UUID),
+		"data/type":            validation.Validate(req.Data.Type, validation.Required, validation.In(resources.TimetableTemplateType)),
+		"data/attributes/name": validation.Validate(req.Data.Attributes.Name, validation.NilOrNotEmpty, validation.Length(1, 64)),
+	}
+
+	if chi.URLParam(r, "template_id") != req.Data.Id.String() {
+		errs["data/id"] = fmt.Errorf("query template_id param and body data/id do not match")
+	}
+
+	return req, errs.Filter()
+}
@@ -1,6 +1,8 @@
 package responses
 
 import (
+	"fmt"
+
 	"github.com/chains-lab/places-svc/internal/domain/models"
 	"github.com/chains-lab/places-svc/resources"
 )
@@ -23,8 +25,11 @@ func Place(m models.Place) resources.Place {
 				Name:        m.Name,
 				Address:     m.Address,
 				Description: m.Description,
+				Timezone:    m.Timezone,
 				CreatedAt:   m.CreatedAt,
 				UpdatedAt:   m.UpdatedAt,
+
+				AddressStatus: m.AddressStatus,
 			},
 		},
 	}
@@ -38,6 +43,16 @@ func Place(m models.Place) resources.Place {
 	if m.Phone != nil {
 		resp.Data.Attributes.Phone = m.Phone
 	}
+	if m.Rank != nil {
+		resp.Data.Attributes.Rank = m.Rank
+	}
+	if m.Snippet != nil {
+		resp.Data.Attributes.Snippet = m.Snippet
+	}
+	if m.AddressDetails != nil {
+		addr := PlaceAddress(*m.AddressDetails)
+		resp.Data.Attributes.AddressDetails = &addr
+	}
 
 	if m.Timetable.Table != nil {
 		resp.Included = make([]resources.TimetableData, 0, 1)
@@ -50,12 +65,24 @@ func Place(m models.Place) resources.Place {
 func PlacesCollection(ms models.PlacesCollection) resources.PlacesCollection {
 	resp := resources.PlacesCollection{
 		Data: make([]resources.PlaceData, 0, len(ms.Data)),
-		Links: resources.PaginationData{
-			PageNumber: int64(ms.Page),
-			PageSize:   int64(ms.Size),
-			TotalItems: int64(ms.Total),
+		Links: resources.PlacesPaginationData{
+			PageSize: int64(ms.Size),
 		},
 	}
+	if ms.NextCursor != "" {
+		resp.Links.NextCursor = &ms.NextCursor
+	} else if ms.Page > 0 {
+		page := int64(ms.Page)
+		resp.Links.PageNumber = &page
+	}
+	if !ms.TotalSkipped {
+		total := int64(ms.Total)
+		resp.Links.TotalItems = &total
+	}
+	if ms.Facets != nil {
+		facets := PlaceFacets(*ms.Facets)
+		resp.Facets = &facets
+	}
 
 	for _, m := range ms.Data {
 		place := Place(m).Data
@@ -66,6 +93,49 @@ func PlacesCollection(ms models.PlacesCollection) resources.PlacesCollection {
 	return resp
 }
 
+func PlaceAddress(m models.PlaceAddress) resources.PlaceAddress {
+	return resources.PlaceAddress{
+		Locale:      m.Locale,
+		Formatted:   m.Formatted,
+		Country:     m.Country,
+		CountryCode: m.CountryCode,
+		State:       m.State,
+		City:        m.City,
+		Suburb:      m.Suburb,
+		Street:      m.Street,
+		HouseNumber: m.HouseNumber,
+		Postcode:    m.Postcode,
+	}
+}
+
+func PlaceFacets(m models.PlaceFacets) resources.PlaceFacets {
+	counts := func(ms []models.PlaceFacetCount) []resources.PlaceFacetCount {
+		if ms == nil {
+			return nil
+		}
+		res := make([]resources.PlaceFacetCount, 0, len(ms))
+		for _, m := range ms {
+			res = append(res, resources.PlaceFacetCount{
+				Value: m.Value,
+				Count: int64(m.Count),
+			})
+		}
+		return res
+	}
+
+	resp := resources.PlaceFacets{
+		Class:    counts(m.Class),
+		Status:   counts(m.Status),
+		Verified: counts(m.Verified),
+	}
+	if m.OpenNow != nil {
+		openNow := int64(*m.OpenNow)
+		resp.OpenNow = &openNow
+	}
+
+	return resp
+}
+
 func PlaceLocale(m models.PlaceLocale) resources.PlaceLocale {
 	return resources.PlaceLocale{
 		Data: resources.PlaceLocaleData{
@@ -102,3 +172,66 @@ func PlaceLocalesCollection(ms models.PlaceLocaleCollection) resources.PlaceLoca
 
 	return resp
 }
+
+func PlaceSuggestions(ms []models.PlaceSuggestion) resources.PlaceSuggestionsCollection {
+	resp := resources.PlaceSuggestionsCollection{
+		Data: make([]resources.PlaceSuggestionData, 0, len(ms)),
+	}
+
+	for _, m := range ms {
+		resp.Data = append(resp.Data, resources.PlaceSuggestionData{
+			Id:   m.ID,
+			Type: resources.PlaceSuggestionType,
+			Attributes: resources.PlaceSuggestionDataAttributes{
+				Class:     m.Class,
+				Locale:    m.Locale,
+				Name:      m.Name,
+				DistanceM: m.DistanceM,
+			},
+		})
+	}
+
+	return resp
+}
+
+func PlaceClusters(ms []models.PlaceCluster) resources.PlaceClustersCollection {
+	resp := resources.PlaceClustersCollection{
+		Data: make([]resources.PlaceClusterData, 0, len(ms)),
+	}
+
+	for _, m := range ms {
+		resp.Data = append(resp.Data, resources.PlaceClusterData{
+			Id:   fmt.Sprintf("%d/%d/%d", m.Zoom, m.CellX, m.CellY),
+			Type: resources.PlaceClusterType,
+			Attributes: resources.PlaceClusterDataAttributes{
+				Centroid: resources.Point{
+					Lon: m.Centroid[0],
+					Lat: m.Centroid[1],
+				},
+				Count:    int64(m.Count),
+				PlaceIds: m.PlaceIDs,
+			},
+		})
+	}
+
+	return resp
+}
+
+func GeocodeCandidates(ms []models.GeoCandidate) resources.GeocodeCandidatesCollection {
+	data := make([]resources.GeocodeCandidateData, 0, len(ms))
+	for _, m := range ms {
+		data = append(data, resources.GeocodeCandidateData{
+			Id:   m.ID,
+			Type: resources.GeocodeCandidateType,
+			Attributes: resources.GeocodeCandidateDataAttributes{
+				Point: resources.Point{
+					Lon: m.Point[0],
+					Lat: m.Point[1],
+				},
+				Address: PlaceAddress(m.Address),
+			},
+		})
+	}
+
+	return resources.GeocodeCandidatesCollection{Data: data}
+}
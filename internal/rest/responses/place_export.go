@@ -0,0 +1,129 @@
+package responses
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/chains-lab/places-svc/internal/domain/enum"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/chains-lab/places-svc/resources"
+)
+
+const (
+	NDJSONContentType = "application/x-ndjson"
+	CSVContentType    = "text/csv"
+)
+
+func PlaceExport(m models.PlaceExport) resources.PlaceExport {
+	resp := resources.PlaceExport{
+		Id:       m.ID,
+		CityId:   m.CityID,
+		Class:    m.Class,
+		Status:   m.Status,
+		Verified: m.Verified,
+		Point: resources.Point{
+			Lon: m.Point[0],
+			Lat: m.Point[1],
+		},
+		Address:   m.Address,
+		Website:   m.Website,
+		Phone:     m.Phone,
+		Timezone:  m.Timezone,
+		Locales:   make([]resources.PlaceExportLocale, 0, len(m.Locales)),
+		Timetable: make([]resources.TimetableInterval, 0, len(m.Timetable.Table)),
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+	if m.CompanyID != nil {
+		resp.CompanyId = m.CompanyID
+	}
+
+	for _, loc := range m.Locales {
+		resp.Locales = append(resp.Locales, resources.PlaceExportLocale{
+			Locale:      loc.Locale,
+			Name:        loc.Name,
+			Description: loc.Description,
+		})
+	}
+	for _, interval := range m.Timetable.Table {
+		resp.Timetable = append(resp.Timetable, TimetableInterval(interval))
+	}
+
+	return resp
+}
+
+// PlaceExportCSVHeader lists the columns of the CSV export. They are the columns of the CSV import,
+// so an exported file can be edited and imported back.
+func PlaceExportCSVHeader() []string {
+	header := []string{
+		"id", "city_id", "company_id", "class", "status", "verified", "lon", "lat",
+		"address", "phone", "website", "timezone", "locale", "name", "description", "timetable",
+	}
+	for _, locale := range enum.GetAllLocales() {
+		header = append(header, "name_"+locale, "description_"+locale)
+	}
+	header = append(header, "created_at", "updated_at")
+
+	return header
+}
+
+// PlaceExportCSVRecord is a CSV export row. The localization in the given locale goes to the
+// locale, name and description columns, or the first one if the place has none in it;
+// the others go to the name_<locale> and description_<locale> columns.
+func PlaceExportCSVRecord(m models.PlaceExport, locale string) []string {
+	var main models.PlaceLocale
+	if len(m.Locales) > 0 {
+		main = m.Locales[0]
+	}
+	others := make(map[string]models.PlaceLocale, len(m.Locales))
+	for _, loc := range m.Locales {
+		if loc.Locale == locale {
+			main = loc
+		}
+		others[loc.Locale] = loc
+	}
+	delete(others, main.Locale)
+
+	optional := func(v *string) string {
+		if v == nil {
+			return ""
+		}
+		return *v
+	}
+
+	companyID := ""
+	if m.CompanyID != nil {
+		companyID = m.CompanyID.String()
+	}
+
+	// пустое поле при импорте оставляет расписание как есть, а "off" закрыло бы место
+	timetable := ""
+	if len(m.Timetable.Table) > 0 {
+		timetable = m.Timetable.OpeningHours()
+	}
+
+	record := []string{
+		m.ID.String(),
+		m.CityID.String(),
+		companyID,
+		m.Class,
+		m.Status,
+		strconv.FormatBool(m.Verified),
+		strconv.FormatFloat(m.Point[0], 'f', -1, 64),
+		strconv.FormatFloat(m.Point[1], 'f', -1, 64),
+		m.Address,
+		optional(m.Phone),
+		optional(m.Website),
+		m.Timezone,
+		main.Locale,
+		main.Name,
+		main.Description,
+		timetable,
+	}
+	for _, loc := range enum.GetAllLocales() {
+		record = append(record, others[loc].Name, others[loc].Description)
+	}
+	record = append(record, m.CreatedAt.Format(time.RFC3339), m.UpdatedAt.Format(time.RFC3339))
+
+	return record
+}
@@ -0,0 +1,51 @@
+package responses
+
+import (
+	"fmt"
+
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/paulmach/orb/geojson"
+)
+
+// GeoJSONContentType is the media type of RFC 7946 GeoJSON documents.
+const GeoJSONContentType = "application/geo+json"
+
+// PlaceFeature is a place as a GeoJSON Point feature. Its properties are the JSON:API place attributes
+// except the point itself, which becomes the geometry; the timetable is not included.
+func PlaceFeature(m models.Place) (*geojson.Feature, error) {
+	props, err := Place(m).Data.Attributes.ToMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build properties of place %s: %w", m.ID, err)
+	}
+	delete(props, "point")
+
+	f := geojson.NewFeature(m.Point)
+	f.ID = m.ID.String()
+	f.Properties = props
+
+	return f, nil
+}
+
+// PlacesFeatureCollection is a page of places as a GeoJSON FeatureCollection,
+// the pagination and the facets go to the "links" and "facets" foreign members as in the JSON:API collection.
+func PlacesFeatureCollection(ms models.PlacesCollection) (*geojson.FeatureCollection, error) {
+	fc := geojson.NewFeatureCollection()
+	fc.Features = make([]*geojson.Feature, 0, len(ms.Data))
+
+	for _, m := range ms.Data {
+		f, err := PlaceFeature(m)
+		if err != nil {
+			return nil, err
+		}
+		fc.Append(f)
+	}
+
+	fc.ExtraMembers = geojson.Properties{
+		"links": PlacesCollection(ms).Links,
+	}
+	if ms.Facets != nil {
+		fc.ExtraMembers["facets"] = PlaceFacets(*ms.Facets)
+	}
+
+	return fc, nil
+}
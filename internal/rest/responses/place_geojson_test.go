@@ -0,0 +1,104 @@
+package responses
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/google/uuid"
+	"github.com/paulmach/orb"
+)
+
+func TestPlacesFeatureCollection(t *testing.T) {
+	openNow := uint64(1)
+	place := models.Place{
+		ID:        uuid.New(),
+		CityID:    uuid.New(),
+		Class:     "food",
+		Status:    "active",
+		Point:     orb.Point{30.52, 50.45},
+		Locale:    "en",
+		Name:      "Pizza",
+		Address:   "Main St",
+		Timezone:  "Europe/Kyiv",
+		CreatedAt: time.Now().UTC(),
+		UpdatedAt: time.Now().UTC(),
+	}
+
+	fc, err := PlacesFeatureCollection(models.PlacesCollection{
+		Data:  []models.Place{place},
+		Page:  1,
+		Size:  10,
+		Total: 1,
+		Facets: &models.PlaceFacets{
+			Class:   []models.PlaceFacetCount{{Value: "food", Count: 1}},
+			OpenNow: &openNow,
+		},
+	})
+	if err != nil {
+		t.Fatalf("PlacesFeatureCollection: %v", err)
+	}
+
+	raw, err := json.Marshal(fc)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	var doc struct {
+		Type     string `json:"type"`
+		Features []struct {
+			Type     string `json:"type"`
+			ID       string `json:"id"`
+			Geometry struct {
+				Type        string    `json:"type"`
+				Coordinates []float64 `json:"coordinates"`
+			} `json:"geometry"`
+			Properties map[string]any `json:"properties"`
+		} `json:"features"`
+		Links  map[string]any `json:"links"`
+		Facets map[string]any `json:"facets"`
+	}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	if doc.Type != "FeatureCollection" || len(doc.Features) != 1 {
+		t.Fatalf("expected a FeatureCollection of 1 feature, got %s", raw)
+	}
+
+	f := doc.Features[0]
+	if f.Type != "Feature" || f.ID != place.ID.String() {
+		t.Fatalf("expected feature %s, got %s %q", place.ID, f.Type, f.ID)
+	}
+	if f.Geometry.Type != "Point" || len(f.Geometry.Coordinates) != 2 ||
+		f.Geometry.Coordinates[0] != 30.52 || f.Geometry.Coordinates[1] != 50.45 {
+		t.Fatalf("expected Point [30.52 50.45], got %s %v", f.Geometry.Type, f.Geometry.Coordinates)
+	}
+	if _, ok := f.Properties["point"]; ok {
+		t.Fatalf("point must not be a property, got %v", f.Properties)
+	}
+	if f.Properties["name"] != "Pizza" || f.Properties["class"] != "food" {
+		t.Fatalf("expected the place attributes in properties, got %v", f.Properties)
+	}
+
+	if doc.Links["page_size"] != float64(10) || doc.Links["total_items"] != float64(1) {
+		t.Fatalf("expected pagination links, got %v", doc.Links)
+	}
+	if doc.Facets["open_now"] != float64(1) || doc.Facets["class"] == nil {
+		t.Fatalf("expected facets, got %v", doc.Facets)
+	}
+
+	t.Run("without facets", func(t *testing.T) {
+		fc, err := PlacesFeatureCollection(models.PlacesCollection{Size: 10})
+		if err != nil {
+			t.Fatalf("PlacesFeatureCollection: %v", err)
+		}
+		if _, ok := fc.ExtraMembers["facets"]; ok {
+			t.Fatalf("expected no facets member, got %v", fc.ExtraMembers)
+		}
+		if fc.Features == nil {
+			t.Fatalf("expected an empty features list, not null")
+		}
+	})
+}
@@ -0,0 +1,37 @@
+package responses
+
+import (
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/chains-lab/places-svc/resources"
+	"github.com/google/uuid"
+)
+
+func PlacesImport(id uuid.UUID, m models.PlaceImportReport) resources.PlacesImport {
+	resp := resources.PlacesImport{
+		Data: resources.PlacesImportData{
+			Id:   id,
+			Type: resources.PlacesImportType,
+			Attributes: resources.PlacesImportDataAttributes{
+				DryRun:  m.DryRun,
+				Created: int64(m.Created),
+				Updated: int64(m.Updated),
+				Failed:  int64(m.Failed),
+				Rows:    make([]resources.PlacesImportRow, 0, len(m.Rows)),
+			},
+		},
+	}
+
+	for _, row := range m.Rows {
+		r := resources.PlacesImportRow{
+			Row:     int64(row.Row),
+			Result:  row.Result,
+			PlaceId: row.PlaceID,
+		}
+		if row.Reason != "" {
+			r.Reason = &row.Reason
+		}
+		resp.Data.Attributes.Rows = append(resp.Data.Attributes.Rows, r)
+	}
+
+	return resp
+}
@@ -2,9 +2,11 @@ package responses
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/chains-lab/places-svc/internal/domain/models"
 	"github.com/chains-lab/places-svc/resources"
+	"github.com/google/uuid"
 )
 
 func Timetable(m models.Timetable) resources.Timetable {
@@ -14,7 +16,7 @@ func Timetable(m models.Timetable) resources.Timetable {
 
 	}
 
-	return resources.Timetable{
+	resp := resources.Timetable{
 		Data: resources.TimetableData{
 			Type: resources.TimetableType,
 			Attributes: resources.TimetableDataAttributes{
@@ -22,17 +24,116 @@ func Timetable(m models.Timetable) resources.Timetable {
 			},
 		},
 	}
+
+	if m.Name != "" {
+		resp.Data.Attributes.Name = &m.Name
+	}
+	if m.ValidFrom != nil {
+		from := m.ValidFrom.Format(time.DateOnly)
+		resp.Data.Attributes.ValidFrom = &from
+	}
+	if m.ValidTo != nil {
+		to := m.ValidTo.Format(time.DateOnly)
+		resp.Data.Attributes.ValidTo = &to
+	}
+	if m.TemplateID != nil {
+		resp.Data.Attributes.TemplateId = m.TemplateID
+	}
+
+	if m.Exceptions != nil {
+		resp.Data.Attributes.Exceptions = make([]resources.TimetableExceptionDataAttributes, 0, len(m.Exceptions))
+		for _, e := range m.Exceptions {
+			resp.Data.Attributes.Exceptions = append(resp.Data.Attributes.Exceptions, timetableExceptionAttributes(e))
+		}
+	}
+
+	return resp
 }
 
 func TimetableInterval(i models.TimeInterval) resources.TimetableInterval {
 	return resources.TimetableInterval{
 		From: resources.TimeMoment{
 			Weekday: i.From.Weekday.String(),
-			Time:    fmt.Sprintf("%v:%v", i.From.Time.Hours(), i.From.Time.Minutes()),
+			Time:    formatDayTime(i.From.Time),
 		},
 		To: resources.TimeMoment{
 			Weekday: i.To.Weekday.String(),
-			Time:    fmt.Sprintf("%v:%v", i.From.Time.Hours(), i.From.Time.Minutes()),
+			Time:    formatDayTime(i.To.Time),
 		},
 	}
 }
+
+func TimetableException(placeID uuid.UUID, m models.TimetableException) resources.TimetableException {
+	return resources.TimetableException{
+		Data: timetableExceptionData(placeID, m),
+	}
+}
+
+func TimetableExceptionsCollection(placeID uuid.UUID, ms []models.TimetableException) resources.TimetableExceptionsCollection {
+	resp := resources.TimetableExceptionsCollection{
+		Data: make([]resources.TimetableExceptionData, 0, len(ms)),
+	}
+
+	for _, m := range ms {
+		resp.Data = append(resp.Data, timetableExceptionData(placeID, m))
+	}
+
+	return resp
+}
+
+func timetableExceptionData(placeID uuid.UUID, m models.TimetableException) resources.TimetableExceptionData {
+	return resources.TimetableExceptionData{
+		Id:         placeID.String() + ":" + m.Date.Format(time.DateOnly),
+		Type:       resources.TimetableExceptionType,
+		Attributes: timetableExceptionAttributes(m),
+	}
+}
+
+func timetableExceptionAttributes(m models.TimetableException) resources.TimetableExceptionDataAttributes {
+	intervals := make([]resources.TimeRange, 0, len(m.Intervals))
+	for _, i := range m.Intervals {
+		intervals = append(intervals, resources.TimeRange{
+			From: formatDayTime(i.From),
+			To:   formatDayTime(i.To),
+		})
+	}
+
+	return resources.TimetableExceptionDataAttributes{
+		Date:      m.Date.Format(time.DateOnly),
+		Reason:    m.Reason,
+		Closed:    m.Closed(),
+		Intervals: intervals,
+	}
+}
+
+func TimetableStatus(placeID uuid.UUID, m models.TimetableStatus) resources.TimetableStatus {
+	resp := resources.TimetableStatus{
+		Data: resources.TimetableStatusData{
+			Id:   placeID,
+			Type: resources.TimetableStatusType,
+			Attributes: resources.TimetableStatusDataAttributes{
+				At:       m.At,
+				Timezone: m.At.Location().String(),
+				Open:     m.Open,
+			},
+		},
+	}
+
+	if m.NextOpen != nil {
+		resp.Data.Attributes.NextOpen = m.NextOpen
+	}
+	if m.NextClose != nil {
+		resp.Data.Attributes.NextClose = m.NextClose
+	}
+	if left := m.MinutesLeft(); left != nil {
+		minutes := int64(*left)
+		resp.Data.Attributes.MinutesLeft = &minutes
+	}
+
+	return resp
+}
+
+func formatDayTime(d time.Duration) string {
+	minutes := int(d / time.Minute)
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
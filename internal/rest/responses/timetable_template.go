@@ -0,0 +1,48 @@
+package responses
+
+import (
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/chains-lab/places-svc/resources"
+)
+
+func TimetableTemplate(m models.TimetableTemplate) resources.TimetableTemplate {
+	return resources.TimetableTemplate{
+		Data: timetableTemplateData(m),
+	}
+}
+
+func TimetableTemplatesCollection(ms models.TimetableTemplatesCollection) resources.TimetableTemplatesCollection {
+	resp := resources.TimetableTemplatesCollection{
+		Data: make([]resources.TimetableTemplateData, 0, len(ms.Data)),
+		Links: resources.PaginationData{
+			PageNumber: int64(ms.Page),
+			PageSize:   int64(ms.Size),
+			TotalItems: int64(ms.Total),
+		},
+	}
+
+	for _, m := range ms.Data {
+		resp.Data = append(resp.Data, timetableTemplateData(m))
+	}
+
+	return resp
+}
+
+func timetableTemplateData(m models.TimetableTemplate) resources.TimetableTemplateData {
+	intervals := make([]resources.TimetableInterval, 0, len(m.Table))
+	for _, i := range m.Table {
+		intervals = append(intervals, TimetableInterval(i))
+	}
+
+	return resources.TimetableTemplateData{
+		Id:   m.ID,
+		Type: resources.TimetableTemplateType,
+		Attributes: resources.TimetableTemplateDataAttributes{
+			CompanyId: m.CompanyID,
+			Name:      m.Name,
+			Table:     intervals,
+			CreatedAt: m.CreatedAt,
+			UpdatedAt: m.UpdatedAt,
+		},
+	}
+}
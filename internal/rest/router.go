@@ -18,6 +18,12 @@ type Handlers interface {
 
 	GetPlace(w http.ResponseWriter, r *http.Request)
 	FilterPlace(w http.ResponseWriter, r *http.Request)
+	SuggestPlaces(w http.ResponseWriter, r *http.Request)
+	SearchPlaces(w http.ResponseWriter, r *http.Request)
+	GetPlaceClusters(w http.ResponseWriter, r *http.Request)
+	GetPlacesTile(w http.ResponseWriter, r *http.Request)
+	ImportPlaces(w http.ResponseWriter, r *http.Request)
+	ExportPlaces(w http.ResponseWriter, r *http.Request)
 
 	UpdatePlace(w http.ResponseWriter, r *http.Request)
 	UpdateVerifiedPlace(w http.ResponseWriter, r *http.Request)
@@ -27,7 +33,22 @@ type Handlers interface {
 
 	SetTimetable(w http.ResponseWriter, r *http.Request)
 	GetTimetable(w http.ResponseWriter, r *http.Request)
+	GetTimetableStatus(w http.ResponseWriter, r *http.Request)
 	DeleteTimetable(w http.ResponseWriter, r *http.Request)
+	SetTimetableDay(w http.ResponseWriter, r *http.Request)
+	DeleteTimetableDay(w http.ResponseWriter, r *http.Request)
+	LinkTimetableTemplate(w http.ResponseWriter, r *http.Request)
+	DetachTimetableTemplate(w http.ResponseWriter, r *http.Request)
+
+	SetTimetableException(w http.ResponseWriter, r *http.Request)
+	GetTimetableExceptions(w http.ResponseWriter, r *http.Request)
+	DeleteTimetableException(w http.ResponseWriter, r *http.Request)
+
+	CreateTimetableTemplate(w http.ResponseWriter, r *http.Request)
+	GetTimetableTemplate(w http.ResponseWriter, r *http.Request)
+	FilterTimetableTemplates(w http.ResponseWriter, r *http.Request)
+	UpdateTimetableTemplate(w http.ResponseWriter, r *http.Request)
+	DeleteTimetableTemplate(w http.ResponseWriter, r *http.Request)
 
 	SetLocalesForPlace(w http.ResponseWriter, r *http.Request)
 	GetLocalesForPlace(w http.ResponseWriter, r *http.Request)
@@ -103,10 +124,31 @@ func Run(ctx context.Context, cfg internal.Config, log logium.Logger, m Middlewa
 				})
 			})
 
+			// права на шаблоны проверяет контроллер: шаблон принадлежит компании пользователя
+			r.Route("/timetable-templates", func(r chi.Router) {
+				r.Use(auth)
+				r.Get("/", h.FilterTimetableTemplates)
+				r.Post("/", h.CreateTimetableTemplate)
+
+				r.Route("/{template_id}", func(r chi.Router) {
+					r.Get("/", h.GetTimetableTemplate)
+					r.Put("/", h.UpdateTimetableTemplate)
+					r.Delete("/", h.DeleteTimetableTemplate)
+				})
+			})
+
 			r.Route("/places", func(r chi.Router) {
 				r.Get("/", h.FilterPlace)
+				r.Get("/suggest", h.SuggestPlaces)
+				r.Post("/search", h.SearchPlaces)
+				r.Get("/clusters", h.GetPlaceClusters)
+				r.Get("/tiles/{z}/{x}/{y}.mvt", h.GetPlacesTile)
 
 				r.With(auth).Post("/", h.CreatePlace)
+				// права на импорт проверяет контроллер: админ сервиса или админ компании для мест своей компании
+				r.With(auth).Post("/import", h.ImportPlaces)
+				// выгрузка: админ сервиса или владелец компании для мест своей компании
+				r.With(auth).Get("/export", h.ExportPlaces)
 				r.With(auth, companyModer).Put("/", h.UpdatePlace)
 				r.With(auth, companyAdmin).Delete("/", h.DeletePlace)
 
@@ -124,11 +166,28 @@ func Run(ctx context.Context, cfg internal.Config, log logium.Logger, m Middlewa
 
 					r.Route("/timetable", func(r chi.Router) {
 						r.Get("/", h.GetTimetable)
+						r.Get("/status", h.GetTimetableStatus)
 
 						r.Group(func(r chi.Router) {
 							r.Use(auth, companyModer)
 							r.Put("/", h.SetTimetable)
 							r.Delete("/", h.DeleteTimetable)
+
+							r.Put("/template", h.LinkTimetableTemplate)
+							r.Delete("/template", h.DetachTimetableTemplate)
+
+							r.Put("/{weekday}", h.SetTimetableDay)
+							r.Delete("/{weekday}", h.DeleteTimetableDay)
+						})
+
+						r.Route("/exceptions", func(r chi.Router) {
+							r.Get("/", h.GetTimetableExceptions)
+
+							r.Group(func(r chi.Router) {
+								r.Use(auth, companyModer)
+								r.Put("/", h.SetTimetableException)
+								r.Delete("/{date}", h.DeleteTimetableException)
+							})
 						})
 					})
 				})
@@ -1,11 +1,19 @@
 package resources
 
 const (
-	PlaceType       = "place"
-	PlaceLocaleType = "place_locale"
+	PlaceType            = "place"
+	PlaceLocaleType      = "place_locale"
+	PlaceSuggestionType  = "place_suggestion"
+	PlacesSearchType     = "places_search"
+	PlaceClusterType     = "place_cluster"
+	PlacesImportType     = "places_import"
+	GeocodeCandidateType = "geocode_candidate"
 
 	ClassType       = "place_class"
 	ClassLocaleType = "place_class_locale"
-	
-	TimetableType = "place_timetable"
+
+	TimetableType          = "place_timetable"
+	TimetableExceptionType = "place_timetable_exception"
+	TimetableStatusType    = "place_timetable_status"
+	TimetableTemplateType  = "timetable_template"
 )
@@ -22,13 +22,16 @@ var _ MappedNullable = &CreatePlaceDataAttributes{}
 
 // CreatePlaceDataAttributes struct for CreatePlaceDataAttributes
 type CreatePlaceDataAttributes struct {
-	// city id
-	CityId uuid.UUID `json:"city_id"`
+	// city id, the known city whose boundary covers the point when omitted
+	CityId *uuid.UUID `json:"city_id,omitempty"`
 	// distributor id
 	DistributorId *uuid.UUID `json:"place_id,omitempty"`
 	// place class
 	Class string `json:"class"`
-	Point Point `json:"point"`
+	// place point, found by geocoding the address when omitted
+	Point *Point `json:"point,omitempty"`
+	// place address, geocoded to the point when the point is omitted
+	Address *string `json:"address,omitempty"`
 	// locale
 	Locale string `json:"locale"`
 	// place name
@@ -39,6 +42,8 @@ type CreatePlaceDataAttributes struct {
 	Website *string `json:"website,omitempty"`
 	// place phone number
 	Phone *string `json:"phone,omitempty"`
+	// IANA time zone of the place, defaults to UTC
+	Timezone *string `json:"timezone,omitempty"`
 }
 
 type _CreatePlaceDataAttributes CreatePlaceDataAttributes
@@ -47,11 +52,9 @@ type _CreatePlaceDataAttributes CreatePlaceDataAttributes
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewCreatePlaceDataAttributes(cityId uuid.UUID, class string, point Point, locale string, name string, description string) *CreatePlaceDataAttributes {
+func NewCreatePlaceDataAttributes(class string, locale string, name string, description string) *CreatePlaceDataAttributes {
 	this := CreatePlaceDataAttributes{}
-	this.CityId = cityId
 	this.Class = class
-	this.Point = point
 	this.Locale = locale
 	this.Name = name
 	this.Description = description
@@ -66,28 +69,36 @@ func NewCreatePlaceDataAttributesWithDefaults() *CreatePlaceDataAttributes {
 	return &this
 }
 
-// GetCityId returns the CityId field value
+// GetCityId returns the CityId field value if set, zero value otherwise.
 func (o *CreatePlaceDataAttributes) GetCityId() uuid.UUID {
-	if o == nil {
+	if o == nil || IsNil(o.CityId) {
 		var ret uuid.UUID
 		return ret
 	}
-
-	return o.CityId
+	return *o.CityId
 }
 
-// GetCityIdOk returns a tuple with the CityId field value
+// GetCityIdOk returns a tuple with the CityId field value if set, nil otherwise
 // and a boolean to check if the value has been set.
 func (o *CreatePlaceDataAttributes) GetCityIdOk() (*uuid.UUID, bool) {
-	if o == nil {
+	if o == nil || IsNil(o.CityId) {
 		return nil, false
 	}
-	return &o.CityId, true
+	return o.CityId, true
 }
 
-// SetCityId sets field value
+// HasCityId returns a boolean if a field has been set.
+func (o *CreatePlaceDataAttributes) HasCityId() bool {
+	if o != nil && !IsNil(o.CityId) {
+		return true
+	}
+
+	return false
+}
+
+// SetCityId gets a reference to the given uuid.UUID and assigns it to the CityId field.
 func (o *CreatePlaceDataAttributes) SetCityId(v uuid.UUID) {
-	o.CityId = v
+	o.CityId = &v
 }
 
 // GetDistributorId returns the DistributorId field value if set, zero value otherwise.
@@ -146,28 +157,68 @@ func (o *CreatePlaceDataAttributes) SetClass(v string) {
 	o.Class = v
 }
 
-// GetPoint returns the Point field value
+// GetPoint returns the Point field value if set, zero value otherwise.
 func (o *CreatePlaceDataAttributes) GetPoint() Point {
-	if o == nil {
+	if o == nil || IsNil(o.Point) {
 		var ret Point
 		return ret
 	}
-
-	return o.Point
+	return *o.Point
 }
 
-// GetPointOk returns a tuple with the Point field value
+// GetPointOk returns a tuple with the Point field value if set, nil otherwise
 // and a boolean to check if the value has been set.
 func (o *CreatePlaceDataAttributes) GetPointOk() (*Point, bool) {
-	if o == nil {
+	if o == nil || IsNil(o.Point) {
 		return nil, false
 	}
-	return &o.Point, true
+	return o.Point, true
 }
 
-// SetPoint sets field value
+// HasPoint returns a boolean if a field has been set.
+func (o *CreatePlaceDataAttributes) HasPoint() bool {
+	if o != nil && !IsNil(o.Point) {
+		return true
+	}
+
+	return false
+}
+
+// SetPoint gets a reference to the given Point and assigns it to the Point field.
 func (o *CreatePlaceDataAttributes) SetPoint(v Point) {
-	o.Point = v
+	o.Point = &v
+}
+
+// GetAddress returns the Address field value if set, zero value otherwise.
+func (o *CreatePlaceDataAttributes) GetAddress() string {
+	if o == nil || IsNil(o.Address) {
+		var ret string
+		return ret
+	}
+	return *o.Address
+}
+
+// GetAddressOk returns a tuple with the Address field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreatePlaceDataAttributes) GetAddressOk() (*string, bool) {
+	if o == nil || IsNil(o.Address) {
+		return nil, false
+	}
+	return o.Address, true
+}
+
+// HasAddress returns a boolean if a field has been set.
+func (o *CreatePlaceDataAttributes) HasAddress() bool {
+	if o != nil && !IsNil(o.Address) {
+		return true
+	}
+
+	return false
+}
+
+// SetAddress gets a reference to the given string and assigns it to the Address field.
+func (o *CreatePlaceDataAttributes) SetAddress(v string) {
+	o.Address = &v
 }
 
 // GetLocale returns the Locale field value
@@ -306,6 +357,38 @@ func (o *CreatePlaceDataAttributes) SetPhone(v string) {
 	o.Phone = &v
 }
 
+// GetTimezone returns the Timezone field value if set, zero value otherwise.
+func (o *CreatePlaceDataAttributes) GetTimezone() string {
+	if o == nil || IsNil(o.Timezone) {
+		var ret string
+		return ret
+	}
+	return *o.Timezone
+}
+
+// GetTimezoneOk returns a tuple with the Timezone field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *CreatePlaceDataAttributes) GetTimezoneOk() (*string, bool) {
+	if o == nil || IsNil(o.Timezone) {
+		return nil, false
+	}
+	return o.Timezone, true
+}
+
+// HasTimezone returns a boolean if a field has been set.
+func (o *CreatePlaceDataAttributes) HasTimezone() bool {
+	if o != nil && !IsNil(o.Timezone) {
+		return true
+	}
+
+	return false
+}
+
+// SetTimezone gets a reference to the given string and assigns it to the Timezone field.
+func (o *CreatePlaceDataAttributes) SetTimezone(v string) {
+	o.Timezone = &v
+}
+
 func (o CreatePlaceDataAttributes) MarshalJSON() ([]byte, error) {
 	toSerialize,err := o.ToMap()
 	if err != nil {
@@ -316,12 +399,19 @@ func (o CreatePlaceDataAttributes) MarshalJSON() ([]byte, error) {
 
 func (o CreatePlaceDataAttributes) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
-	toSerialize["city_id"] = o.CityId
+	if !IsNil(o.CityId) {
+		toSerialize["city_id"] = o.CityId
+	}
 	if !IsNil(o.DistributorId) {
 		toSerialize["place_id"] = o.DistributorId
 	}
 	toSerialize["class"] = o.Class
-	toSerialize["point"] = o.Point
+	if !IsNil(o.Point) {
+		toSerialize["point"] = o.Point
+	}
+	if !IsNil(o.Address) {
+		toSerialize["address"] = o.Address
+	}
 	toSerialize["locale"] = o.Locale
 	toSerialize["name"] = o.Name
 	toSerialize["description"] = o.Description
@@ -331,6 +421,9 @@ func (o CreatePlaceDataAttributes) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Phone) {
 		toSerialize["phone"] = o.Phone
 	}
+	if !IsNil(o.Timezone) {
+		toSerialize["timezone"] = o.Timezone
+	}
 	return toSerialize, nil
 }
 
@@ -339,9 +432,7 @@ func (o *CreatePlaceDataAttributes) UnmarshalJSON(data []byte) (err error) {
 	// by unmarshalling the object into a generic map with string keys and checking
 	// that every required field exists as a key in the generic map.
 	requiredProperties := []string{
-		"city_id",
 		"class",
-		"point",
 		"locale",
 		"name",
 		"description",
@@ -40,12 +40,22 @@ type PlaceDataAttributes struct {
 	Name string `json:"name"`
 	// place address
 	Address string `json:"address"`
+	// structured address found by reverse geocoding of the point
+	AddressDetails *PlaceAddress `json:"address_details,omitempty"`
+	// state of the address lookup: pending, resolved or failed
+	AddressStatus string `json:"address_status"`
 	// place description
 	Description string `json:"description"`
 	// place website
 	Website *string `json:"website,omitempty"`
 	// place phone number
 	Phone *string `json:"phone,omitempty"`
+	// IANA time zone of the place
+	Timezone string `json:"timezone"`
+	// full-text search relevance, only for places found by ?q=. en and ru localizations are searched with stemming, uk and any other locale fall back to the simple configuration with no stemming, so only whole word forms match
+	Rank *float64 `json:"rank,omitempty"`
+	// matched text with the query words marked, only with ?highlight=true
+	Snippet *string `json:"snippet,omitempty"`
 	// place creation date
 	CreatedAt time.Time `json:"created_at"`
 	// place last update date
@@ -58,7 +68,7 @@ type _PlaceDataAttributes PlaceDataAttributes
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewPlaceDataAttributes(cityId uuid.UUID, class string, status string, verified bool, point Point, locale string, name string, address string, description string, createdAt time.Time, updatedAt time.Time) *PlaceDataAttributes {
+func NewPlaceDataAttributes(cityId uuid.UUID, class string, status string, verified bool, point Point, locale string, name string, address string, addressStatus string, description string, timezone string, createdAt time.Time, updatedAt time.Time) *PlaceDataAttributes {
 	this := PlaceDataAttributes{}
 	this.CityId = cityId
 	this.Class = class
@@ -68,7 +78,9 @@ func NewPlaceDataAttributes(cityId uuid.UUID, class string, status string, verif
 	this.Locale = locale
 	this.Name = name
 	this.Address = address
+	this.AddressStatus = addressStatus
 	this.Description = description
+	this.Timezone = timezone
 	this.CreatedAt = createdAt
 	this.UpdatedAt = updatedAt
 	return &this
@@ -306,6 +318,62 @@ func (o *PlaceDataAttributes) SetAddress(v string) {
 	o.Address = v
 }
 
+// GetAddressDetails returns the AddressDetails field value if set, zero value otherwise.
+func (o *PlaceDataAttributes) GetAddressDetails() PlaceAddress {
+	if o == nil || IsNil(o.AddressDetails) {
+		var ret PlaceAddress
+		return ret
+	}
+	return *o.AddressDetails
+}
+
+// GetAddressDetailsOk returns a tuple with the AddressDetails field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *PlaceDataAttributes) GetAddressDetailsOk() (*PlaceAddress, bool) {
+	if o == nil || IsNil(o.AddressDetails) {
+		return nil, false
+	}
+	return o.AddressDetails, true
+}
+
+// HasAddressDetails returns a boolean if a field has been set.
+func (o *PlaceDataAttributes) HasAddressDetails() bool {
+	if o != nil && !IsNil(o.AddressDetails) {
+		return true
+	}
+
+	return false
+}
+
+// SetAddressDetails gets a reference to the given PlaceAddress and assigns it to the AddressDetails field.
+func (o *PlaceDataAttributes) SetAddressDetails(v PlaceAddress) {
+	o.AddressDetails = &v
+}
+
+// GetAddressStatus returns the AddressStatus field value
+func (o *PlaceDataAttributes) GetAddressStatus() string {
+	if o == nil {
+		var ret string
+		return ret
+	}
+
+	return o.AddressStatus
+}
+
+// GetAddressStatusOk returns a tuple with the AddressStatus field value
+// and a boolean to check if the value has been set.
+func (o *PlaceDataAttributes) GetAddressStatusOk() (*string, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.AddressStatus, true
+}
+
+// SetAddressStatus sets field value
+func (o *PlaceDataAttributes) SetAddressStatus(v string) {
+	o.AddressStatus = v
+}
+
 // GetDescription returns the Description field value
 func (o *PlaceDataAttributes) GetDescription() string {
 	if o == nil {
@@ -394,6 +462,94 @@ func (o *PlaceDataAttributes) SetPhone(v string) {
 	o.Phone = &v
 }
 
+// GetTimezone returns the Timezone field value
+func (o *PlaceDataAttributes) GetTimezone() string {
+	if o == nil {
+		var ret string
+		return ret
+	}
+
+	return o.Timezone
+}
+
+// GetTimezoneOk returns a tuple with the Timezone field value
+// and a boolean to check if the value has been set.
+func (o *PlaceDataAttributes) GetTimezoneOk() (*string, bool) {
+	if o == nil {
+		return nil, false
+	}
+	return &o.Timezone, true
+}
+
+// SetTimezone sets field value
+func (o *PlaceDataAttributes) SetTimezone(v string) {
+	o.Timezone = v
+}
+
+// GetRank returns the Rank field value if set, zero value otherwise.
+func (o *PlaceDataAttributes) GetRank() float64 {
+	if o == nil || IsNil(o.Rank) {
+		var ret float64
+		return ret
+	}
+	return *o.Rank
+}
+
+// GetRankOk returns a tuple with the Rank field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *PlaceDataAttributes) GetRankOk() (*float64, bool) {
+	if o == nil || IsNil(o.Rank) {
+		return nil, false
+	}
+	return o.Rank, true
+}
+
+// HasRank returns a boolean if a field has been set.
+func (o *PlaceDataAttributes) HasRank() bool {
+	if o != nil && !IsNil(o.Rank) {
+		return true
+	}
+
+	return false
+}
+
+// SetRank gets a reference to the given float64 and assigns it to the Rank field.
+func (o *PlaceDataAttributes) SetRank(v float64) {
+	o.Rank = &v
+}
+
+// GetSnippet returns the Snippet field value if set, zero value otherwise.
+func (o *PlaceDataAttributes) GetSnippet() string {
+	if o == nil || IsNil(o.Snippet) {
+		var ret string
+		return ret
+	}
+	return *o.Snippet
+}
+
+// GetSnippetOk returns a tuple with the Snippet field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *PlaceDataAttributes) GetSnippetOk() (*string, bool) {
+	if o == nil || IsNil(o.Snippet) {
+		return nil, false
+	}
+	return o.Snippet, true
+}
+
+// HasSnippet returns a boolean if a field has been set.
+func (o *PlaceDataAttributes) HasSnippet() bool {
+	if o != nil && !IsNil(o.Snippet) {
+		return true
+	}
+
+	return false
+}
+
+// SetSnippet gets a reference to the given string and assigns it to the Snippet field.
+func (o *PlaceDataAttributes) SetSnippet(v string) {
+	o.Snippet = &v
+}
+
 // GetCreatedAt returns the CreatedAt field value
 func (o *PlaceDataAttributes) GetCreatedAt() time.Time {
 	if o == nil {
@@ -463,6 +619,10 @@ func (o PlaceDataAttributes) ToMap() (map[string]interface{}, error) {
 	toSerialize["locale"] = o.Locale
 	toSerialize["name"] = o.Name
 	toSerialize["address"] = o.Address
+	if !IsNil(o.AddressDetails) {
+		toSerialize["address_details"] = o.AddressDetails
+	}
+	toSerialize["address_status"] = o.AddressStatus
 	toSerialize["description"] = o.Description
 	if !IsNil(o.Website) {
 		toSerialize["website"] = o.Website
@@ -470,6 +630,13 @@ func (o PlaceDataAttributes) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Phone) {
 		toSerialize["phone"] = o.Phone
 	}
+	toSerialize["timezone"] = o.Timezone
+	if !IsNil(o.Rank) {
+		toSerialize["rank"] = o.Rank
+	}
+	if !IsNil(o.Snippet) {
+		toSerialize["snippet"] = o.Snippet
+	}
 	toSerialize["created_at"] = o.CreatedAt
 	toSerialize["updated_at"] = o.UpdatedAt
 	return toSerialize, nil
@@ -488,7 +655,9 @@ func (o *PlaceDataAttributes) UnmarshalJSON(data []byte) (err error) {
 		"locale",
 		"name",
 		"address",
+		"address_status",
 		"description",
+		"timezone",
 		"created_at",
 		"updated_at",
 	}
@@ -23,7 +23,9 @@ var _ MappedNullable = &PlacesCollection{}
 type PlacesCollection struct {
 	Data []PlaceData `json:"data"`
 	Included []TimetableData `json:"included"`
-	Links PaginationData `json:"links"`
+	Links PlacesPaginationData `json:"links"`
+	// Counts of the matching places per facet value, only with the facets parameter.
+	Facets *PlaceFacets `json:"facets,omitempty"`
 }
 
 type _PlacesCollection PlacesCollection
@@ -32,7 +34,7 @@ type _PlacesCollection PlacesCollection
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewPlacesCollection(data []PlaceData, included []TimetableData, links PaginationData) *PlacesCollection {
+func NewPlacesCollection(data []PlaceData, included []TimetableData, links PlacesPaginationData) *PlacesCollection {
 	this := PlacesCollection{}
 	this.Data = data
 	this.Included = included
@@ -97,9 +99,9 @@ func (o *PlacesCollection) SetIncluded(v []TimetableData) {
 }
 
 // GetLinks returns the Links field value
-func (o *PlacesCollection) GetLinks() PaginationData {
+func (o *PlacesCollection) GetLinks() PlacesPaginationData {
 	if o == nil {
-		var ret PaginationData
+		var ret PlacesPaginationData
 		return ret
 	}
 
@@ -108,7 +110,7 @@ func (o *PlacesCollection) GetLinks() PaginationData {
 
 // GetLinksOk returns a tuple with the Links field value
 // and a boolean to check if the value has been set.
-func (o *PlacesCollection) GetLinksOk() (*PaginationData, bool) {
+func (o *PlacesCollection) GetLinksOk() (*PlacesPaginationData, bool) {
 	if o == nil {
 		return nil, false
 	}
@@ -116,10 +118,42 @@ func (o *PlacesCollection) GetLinksOk() (*PaginationData, bool) {
 }
 
 // SetLinks sets field value
-func (o *PlacesCollection) SetLinks(v PaginationData) {
+func (o *PlacesCollection) SetLinks(v PlacesPaginationData) {
 	o.Links = v
 }
 
+// GetFacets returns the Facets field value if set, zero value otherwise.
+func (o *PlacesCollection) GetFacets() PlaceFacets {
+	if o == nil || IsNil(o.Facets) {
+		var ret PlaceFacets
+		return ret
+	}
+	return *o.Facets
+}
+
+// GetFacetsOk returns a tuple with the Facets field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *PlacesCollection) GetFacetsOk() (*PlaceFacets, bool) {
+	if o == nil || IsNil(o.Facets) {
+		return nil, false
+	}
+	return o.Facets, true
+}
+
+// HasFacets returns a boolean if a field has been set.
+func (o *PlacesCollection) HasFacets() bool {
+	if o != nil && !IsNil(o.Facets) {
+		return true
+	}
+
+	return false
+}
+
+// SetFacets gets a reference to the given PlaceFacets and assigns it to the Facets field.
+func (o *PlacesCollection) SetFacets(v PlaceFacets) {
+	o.Facets = &v
+}
+
 func (o PlacesCollection) MarshalJSON() ([]byte, error) {
 	toSerialize,err := o.ToMap()
 	if err != nil {
@@ -133,6 +167,9 @@ func (o PlacesCollection) ToMap() (map[string]interface{}, error) {
 	toSerialize["data"] = o.Data
 	toSerialize["included"] = o.Included
 	toSerialize["links"] = o.Links
+	if !IsNil(o.Facets) {
+		toSerialize["facets"] = o.Facets
+	}
 	return toSerialize, nil
 }
 
@@ -12,6 +12,7 @@ package resources
 
 import (
 	"encoding/json"
+	"github.com/google/uuid"
 	"bytes"
 	"fmt"
 )
@@ -21,8 +22,20 @@ var _ MappedNullable = &TimetableDataAttributes{}
 
 // TimetableDataAttributes struct for TimetableDataAttributes
 type TimetableDataAttributes struct {
+	// timetable name, e.g. summer; 'default' when omitted
+	Name *string `json:"name,omitempty"`
+	// first date the timetable is in force, unbounded when omitted
+	ValidFrom *string `json:"valid_from,omitempty"`
+	// last date the timetable is in force, unbounded when omitted
+	ValidTo *string `json:"valid_to,omitempty"`
+	// template the timetable follows, absent for a local timetable
+	TemplateId *uuid.UUID `json:"template_id,omitempty"`
+	// localized human-readable summary of the weekly hours, only when requested with ?summary=
+	Summary *string `json:"summary,omitempty"`
 	// timetable table
 	Table []TimetableInterval `json:"table"`
+	// upcoming date-specific exceptions
+	Exceptions []TimetableExceptionDataAttributes `json:"exceptions,omitempty"`
 }
 
 type _TimetableDataAttributes TimetableDataAttributes
@@ -45,6 +58,166 @@ func NewTimetableDataAttributesWithDefaults() *TimetableDataAttributes {
 	return &this
 }
 
+// GetName returns the Name field value if set, zero value otherwise.
+func (o *TimetableDataAttributes) GetName() string {
+	if o == nil || IsNil(o.Name) {
+		var ret string
+		return ret
+	}
+	return *o.Name
+}
+
+// GetNameOk returns a tuple with the Name field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TimetableDataAttributes) GetNameOk() (*string, bool) {
+	if o == nil || IsNil(o.Name) {
+		return nil, false
+	}
+	return o.Name, true
+}
+
+// HasName returns a boolean if a field has been set.
+func (o *TimetableDataAttributes) HasName() bool {
+	if o != nil && !IsNil(o.Name) {
+		return true
+	}
+
+	return false
+}
+
+// SetName gets a reference to the given string and assigns it to the Name field.
+func (o *TimetableDataAttributes) SetName(v string) {
+	o.Name = &v
+}
+
+// GetValidFrom returns the ValidFrom field value if set, zero value otherwise.
+func (o *TimetableDataAttributes) GetValidFrom() string {
+	if o == nil || IsNil(o.ValidFrom) {
+		var ret string
+		return ret
+	}
+	return *o.ValidFrom
+}
+
+// GetValidFromOk returns a tuple with the ValidFrom field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TimetableDataAttributes) GetValidFromOk() (*string, bool) {
+	if o == nil || IsNil(o.ValidFrom) {
+		return nil, false
+	}
+	return o.ValidFrom, true
+}
+
+// HasValidFrom returns a boolean if a field has been set.
+func (o *TimetableDataAttributes) HasValidFrom() bool {
+	if o != nil && !IsNil(o.ValidFrom) {
+		return true
+	}
+
+	return false
+}
+
+// SetValidFrom gets a reference to the given string and assigns it to the ValidFrom field.
+func (o *TimetableDataAttributes) SetValidFrom(v string) {
+	o.ValidFrom = &v
+}
+
+// GetValidTo returns the ValidTo field value if set, zero value otherwise.
+func (o *TimetableDataAttributes) GetValidTo() string {
+	if o == nil || IsNil(o.ValidTo) {
+		var ret string
+		return ret
+	}
+	return *o.ValidTo
+}
+
+// GetValidToOk returns a tuple with the ValidTo field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TimetableDataAttributes) GetValidToOk() (*string, bool) {
+	if o == nil || IsNil(o.ValidTo) {
+		return nil, false
+	}
+	return o.ValidTo, true
+}
+
+// HasValidTo returns a boolean if a field has been set.
+func (o *TimetableDataAttributes) HasValidTo() bool {
+	if o != nil && !IsNil(o.ValidTo) {
+		return true
+	}
+
+	return false
+}
+
+// SetValidTo gets a reference to the given string and assigns it to the ValidTo field.
+func (o *TimetableDataAttributes) SetValidTo(v string) {
+	o.ValidTo = &v
+}
+
+// GetTemplateId returns the TemplateId field value if set, zero value otherwise.
+func (o *TimetableDataAttributes) GetTemplateId() uuid.UUID {
+	if o == nil || IsNil(o.TemplateId) {
+		var ret uuid.UUID
+		return ret
+	}
+	return *o.TemplateId
+}
+
+// GetTemplateIdOk returns a tuple with the TemplateId field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TimetableDataAttributes) GetTemplateIdOk() (*uuid.UUID, bool) {
+	if o == nil || IsNil(o.TemplateId) {
+		return nil, false
+	}
+	return o.TemplateId, true
+}
+
+// HasTemplateId returns a boolean if a field has been set.
+func (o *TimetableDataAttributes) HasTemplateId() bool {
+	if o != nil && !IsNil(o.TemplateId) {
+		return true
+	}
+
+	return false
+}
+
+// SetTemplateId gets a reference to the given uuid.UUID and assigns it to the TemplateId field.
+func (o *TimetableDataAttributes) SetTemplateId(v uuid.UUID) {
+	o.TemplateId = &v
+}
+
+// GetSummary returns the Summary field value if set, zero value otherwise.
+func (o *TimetableDataAttributes) GetSummary() string {
+	if o == nil || IsNil(o.Summary) {
+		var ret string
+		return ret
+	}
+	return *o.Summary
+}
+
+// GetSummaryOk returns a tuple with the Summary field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TimetableDataAttributes) GetSummaryOk() (*string, bool) {
+	if o == nil || IsNil(o.Summary) {
+		return nil, false
+	}
+	return o.Summary, true
+}
+
+// HasSummary returns a boolean if a field has been set.
+func (o *TimetableDataAttributes) HasSummary() bool {
+	if o != nil && !IsNil(o.Summary) {
+		return true
+	}
+
+	return false
+}
+
+// SetSummary gets a reference to the given string and assigns it to the Summary field.
+func (o *TimetableDataAttributes) SetSummary(v string) {
+	o.Summary = &v
+}
+
 // GetTable returns the Table field value
 func (o *TimetableDataAttributes) GetTable() []TimetableInterval {
 	if o == nil {
@@ -69,6 +242,38 @@ func (o *TimetableDataAttributes) SetTable(v []TimetableInterval) {
 	o.Table = v
 }
 
+// GetExceptions returns the Exceptions field value if set, zero value otherwise.
+func (o *TimetableDataAttributes) GetExceptions() []TimetableExceptionDataAttributes {
+	if o == nil || IsNil(o.Exceptions) {
+		var ret []TimetableExceptionDataAttributes
+		return ret
+	}
+	return o.Exceptions
+}
+
+// GetExceptionsOk returns a tuple with the Exceptions field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *TimetableDataAttributes) GetExceptionsOk() ([]TimetableExceptionDataAttributes, bool) {
+	if o == nil || IsNil(o.Exceptions) {
+		return nil, false
+	}
+	return o.Exceptions, true
+}
+
+// HasExceptions returns a boolean if a field has been set.
+func (o *TimetableDataAttributes) HasExceptions() bool {
+	if o != nil && !IsNil(o.Exceptions) {
+		return true
+	}
+
+	return false
+}
+
+// SetExceptions gets a reference to the given []TimetableExceptionDataAttributes and assigns it to the Exceptions field.
+func (o *TimetableDataAttributes) SetExceptions(v []TimetableExceptionDataAttributes) {
+	o.Exceptions = v
+}
+
 func (o TimetableDataAttributes) MarshalJSON() ([]byte, error) {
 	toSerialize,err := o.ToMap()
 	if err != nil {
@@ -79,7 +284,25 @@ func (o TimetableDataAttributes) MarshalJSON() ([]byte, error) {
 
 func (o TimetableDataAttributes) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
+	if !IsNil(o.Name) {
+		toSerialize["name"] = o.Name
+	}
+	if !IsNil(o.ValidFrom) {
+		toSerialize["valid_from"] = o.ValidFrom
+	}
+	if !IsNil(o.ValidTo) {
+		toSerialize["valid_to"] = o.ValidTo
+	}
+	if !IsNil(o.TemplateId) {
+		toSerialize["template_id"] = o.TemplateId
+	}
+	if !IsNil(o.Summary) {
+		toSerialize["summary"] = o.Summary
+	}
 	toSerialize["table"] = o.Table
+	if !IsNil(o.Exceptions) {
+		toSerialize["exceptions"] = o.Exceptions
+	}
 	return toSerialize, nil
 }
 
@@ -21,10 +21,16 @@ var _ MappedNullable = &UpdatePlaceDataAttributes{}
 type UpdatePlaceDataAttributes struct {
 	// place class
 	Class *string `json:"class,omitempty"`
+	// place point
+	Point *Point `json:"point,omitempty"`
+	// place address, geocoded to the point when the point is omitted
+	Address *string `json:"address,omitempty"`
 	// place website
 	Website *string `json:"website,omitempty"`
 	// place phone number
 	Phone *string `json:"phone,omitempty"`
+	// IANA time zone of the place
+	Timezone *string `json:"timezone,omitempty"`
 }
 
 // NewUpdatePlaceDataAttributes instantiates a new UpdatePlaceDataAttributes object
@@ -76,6 +82,70 @@ func (o *UpdatePlaceDataAttributes) SetClass(v string) {
 	o.Class = &v
 }
 
+// GetPoint returns the Point field value if set, zero value otherwise.
+func (o *UpdatePlaceDataAttributes) GetPoint() Point {
+	if o == nil || IsNil(o.Point) {
+		var ret Point
+		return ret
+	}
+	return *o.Point
+}
+
+// GetPointOk returns a tuple with the Point field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *UpdatePlaceDataAttributes) GetPointOk() (*Point, bool) {
+	if o == nil || IsNil(o.Point) {
+		return nil, false
+	}
+	return o.Point, true
+}
+
+// HasPoint returns a boolean if a field has been set.
+func (o *UpdatePlaceDataAttributes) HasPoint() bool {
+	if o != nil && !IsNil(o.Point) {
+		return true
+	}
+
+	return false
+}
+
+// SetPoint gets a reference to the given Point and assigns it to the Point field.
+func (o *UpdatePlaceDataAttributes) SetPoint(v Point) {
+	o.Point = &v
+}
+
+// GetAddress returns the Address field value if set, zero value otherwise.
+func (o *UpdatePlaceDataAttributes) GetAddress() string {
+	if o == nil || IsNil(o.Address) {
+		var ret string
+		return ret
+	}
+	return *o.Address
+}
+
+// GetAddressOk returns a tuple with the Address field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *UpdatePlaceDataAttributes) GetAddressOk() (*string, bool) {
+	if o == nil || IsNil(o.Address) {
+		return nil, false
+	}
+	return o.Address, true
+}
+
+// HasAddress returns a boolean if a field has been set.
+func (o *UpdatePlaceDataAttributes) HasAddress() bool {
+	if o != nil && !IsNil(o.Address) {
+		return true
+	}
+
+	return false
+}
+
+// SetAddress gets a reference to the given string and assigns it to the Address field.
+func (o *UpdatePlaceDataAttributes) SetAddress(v string) {
+	o.Address = &v
+}
+
 // GetWebsite returns the Website field value if set, zero value otherwise.
 func (o *UpdatePlaceDataAttributes) GetWebsite() string {
 	if o == nil || IsNil(o.Website) {
@@ -140,6 +210,38 @@ func (o *UpdatePlaceDataAttributes) SetPhone(v string) {
 	o.Phone = &v
 }
 
+// GetTimezone returns the Timezone field value if set, zero value otherwise.
+func (o *UpdatePlaceDataAttributes) GetTimezone() string {
+	if o == nil || IsNil(o.Timezone) {
+		var ret string
+		return ret
+	}
+	return *o.Timezone
+}
+
+// GetTimezoneOk returns a tuple with the Timezone field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *UpdatePlaceDataAttributes) GetTimezoneOk() (*string, bool) {
+	if o == nil || IsNil(o.Timezone) {
+		return nil, false
+	}
+	return o.Timezone, true
+}
+
+// HasTimezone returns a boolean if a field has been set.
+func (o *UpdatePlaceDataAttributes) HasTimezone() bool {
+	if o != nil && !IsNil(o.Timezone) {
+		return true
+	}
+
+	return false
+}
+
+// SetTimezone gets a reference to the given string and assigns it to the Timezone field.
+func (o *UpdatePlaceDataAttributes) SetTimezone(v string) {
+	o.Timezone = &v
+}
+
 func (o UpdatePlaceDataAttributes) MarshalJSON() ([]byte, error) {
 	toSerialize,err := o.ToMap()
 	if err != nil {
@@ -153,12 +255,21 @@ func (o UpdatePlaceDataAttributes) ToMap() (map[string]interface{}, error) {
 	if !IsNil(o.Class) {
 		toSerialize["class"] = o.Class
 	}
+	if !IsNil(o.Point) {
+		toSerialize["point"] = o.Point
+	}
+	if !IsNil(o.Address) {
+		toSerialize["address"] = o.Address
+	}
 	if !IsNil(o.Website) {
 		toSerialize["website"] = o.Website
 	}
 	if !IsNil(o.Phone) {
 		toSerialize["phone"] = o.Phone
 	}
+	if !IsNil(o.Timezone) {
+		toSerialize["timezone"] = o.Timezone
+	}
 	return toSerialize, nil
 }
 
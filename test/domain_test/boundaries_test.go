@@ -0,0 +1,213 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/chains-lab/places-svc/internal/domain/enum"
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/chains-lab/places-svc/internal/domain/services/boundary"
+	"github.com/chains-lab/places-svc/internal/domain/services/place"
+	"github.com/chains-lab/places-svc/test"
+	"github.com/google/uuid"
+	"github.com/paulmach/orb"
+)
+
+func TestPlaceBoundaries(t *testing.T) {
+	s, err := newSetup(t)
+	if err != nil {
+		t.Fatalf("newSetup: %v", err)
+	}
+	test.CleanDB(t)
+
+	ctx := context.Background()
+
+	FoodClass := CreateClass(s, t, "Food", "food", nil)
+	kyivID := uuid.New()
+	lvivID := uuid.New()
+	podilID := uuid.New()
+
+	file := fmt.Sprintf(`{"type": "FeatureCollection", "features": [
+	{"type": "Feature", "properties": {"kind": "district", "city_id": %[3]q, "name": "Podil"},
+	 "id": %[2]q,
+	 "geometry": {"type": "Polygon", "coordinates": [[[30.49, 50.45], [30.53, 50.45], [30.53, 50.48], [30.49, 50.48], [30.49, 50.45]]]}},
+	{"type": "Feature", "properties": {"id": %[3]q, "name": "Kyiv"},
+	 "geometry": {"type": "Polygon", "coordinates": [[[30.3, 50.3], [30.8, 50.3], [30.8, 50.6], [30.3, 50.6], [30.3, 50.3]]]}},
+	{"type": "Feature", "properties": {"id": %[1]q, "name": "Lviv"},
+	 "geometry": {"type": "MultiPolygon", "coordinates": [[[[23.9, 49.7], [24.2, 49.7], [24.2, 49.95], [23.9, 49.95], [23.9, 49.7]]]]}}
+]}`, lvivID, podilID, kyivID)
+
+	boundaries, err := boundary.DecodeGeoJSON(strings.NewReader(file), enum.BoundaryKindCity)
+	if err != nil {
+		t.Fatalf("DecodeGeoJSON: %v", err)
+	}
+
+	report, err := s.domain.boundary.Import(ctx, boundaries)
+	if err != nil {
+		t.Fatalf("Import: %v", err)
+	}
+	if report.Cities != 2 || report.Districts != 1 {
+		t.Fatalf("expected 2 cities and 1 district, got %+v", report)
+	}
+
+	newPlace := func(cityID uuid.UUID, name string, lon, lat float64) (models.Place, error) {
+		return s.domain.place.Create(ctx, place.CreateParams{
+			CityID:      cityID,
+			Class:       FoodClass.Code,
+			Point:       orb.Point{lon, lat},
+			Locale:      enum.LocaleEN,
+			Name:        name,
+			Address:     "Main St",
+			Description: name,
+		})
+	}
+
+	podil, err := newPlace(uuid.Nil, "Podil", 30.51, 50.46)
+	if err != nil {
+		t.Fatalf("Create in Podil: %v", err)
+	}
+	pechersk, err := newPlace(kyivID, "Pechersk", 30.55, 50.43)
+	if err != nil {
+		t.Fatalf("Create in Pechersk: %v", err)
+	}
+
+	t.Run("city is assigned from the point", func(t *testing.T) {
+		if podil.CityID != kyivID {
+			t.Fatalf("expected city %s, got %s", kyivID, podil.CityID)
+		}
+
+		got, err := s.domain.place.Get(ctx, podil.ID, enum.LocaleEN)
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		if got.CityID != kyivID {
+			t.Fatalf("expected stored city %s, got %s", kyivID, got.CityID)
+		}
+	})
+
+	t.Run("point outside of the city", func(t *testing.T) {
+		if _, err := newPlace(lvivID, "Wrong city", 30.52, 50.45); !errors.Is(err, errx.ErrorPlaceOutsideCity) {
+			t.Fatalf("expected ErrorPlaceOutsideCity, got %v", err)
+		}
+
+		lviv := orb.Point{24.03, 49.84}
+		_, err := s.domain.place.Update(ctx, pechersk.ID, enum.LocaleEN, place.UpdateParams{Point: &lviv})
+		if !errors.Is(err, errx.ErrorPlaceOutsideCity) {
+			t.Fatalf("expected ErrorPlaceOutsideCity on update, got %v", err)
+		}
+	})
+
+	t.Run("no city covers the point", func(t *testing.T) {
+		if _, err := newPlace(uuid.Nil, "Nowhere", 10, 10); !errors.Is(err, errx.ErrorPlaceCityNotFound) {
+			t.Fatalf("expected ErrorPlaceCityNotFound, got %v", err)
+		}
+	})
+
+	t.Run("city without a boundary is trusted", func(t *testing.T) {
+		cityID := uuid.New()
+		p, err := newPlace(cityID, "Unknown city", 10, 10)
+		if err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		if p.CityID != cityID {
+			t.Fatalf("expected city %s, got %s", cityID, p.CityID)
+		}
+	})
+
+	t.Run("district filter", func(t *testing.T) {
+		res, err := s.domain.place.Filter(ctx, enum.LocaleEN, place.FilterParams{DistrictID: &podilID}, place.SortParams{}, 1, 10)
+		if err != nil {
+			t.Fatalf("Filter: %v", err)
+		}
+		if res.Total != 1 || len(res.Data) != 1 || res.Data[0].ID != podil.ID {
+			t.Fatalf("expected only Podil, got %v", idsOf(res.Data))
+		}
+
+		res, err = s.domain.place.Filter(ctx, enum.LocaleEN, place.FilterParams{CityID: &kyivID}, place.SortParams{}, 1, 10)
+		if err != nil {
+			t.Fatalf("Filter: %v", err)
+		}
+		if res.Total != 2 {
+			t.Fatalf("expected 2 places in Kyiv, got %d", res.Total)
+		}
+	})
+
+	t.Run("district filter in clusters", func(t *testing.T) {
+		bbox := models.BBox{MinLon: 30, MinLat: 50, MaxLon: 31, MaxLat: 51}
+
+		count := func(filter place.FilterParams) (uint64, []uuid.UUID) {
+			t.Helper()
+			res, err := s.domain.place.Clusters(ctx, filter, bbox, 5)
+			if err != nil {
+				t.Fatalf("Clusters: %v", err)
+			}
+
+			var (
+				total uint64
+				ids   []uuid.UUID
+			)
+			for _, c := range res {
+				total += c.Count
+				ids = append(ids, c.PlaceIDs...)
+			}
+			return total, ids
+		}
+
+		if total, _ := count(place.FilterParams{}); total != 2 {
+			t.Fatalf("expected 2 places in the viewport, got %d", total)
+		}
+
+		total, ids := count(place.FilterParams{DistrictID: &podilID})
+		if total != 1 || len(ids) != 1 || ids[0] != podil.ID {
+			t.Fatalf("expected only Podil in the clusters, got %d places %v", total, ids)
+		}
+	})
+
+	t.Run("invalid boundaries", func(t *testing.T) {
+		square := orb.MultiPolygon{{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}}}
+		unknownCity := uuid.New()
+
+		invalid := [][]models.Boundary{
+			{{ID: uuid.New(), Kind: enum.BoundaryKindDistrict, CityID: &unknownCity, Area: square}},
+			{{ID: uuid.New(), Kind: enum.BoundaryKindDistrict, Area: square}},
+			{{ID: uuid.New(), Kind: "country", Area: square}},
+			{{ID: uuid.New(), Kind: enum.BoundaryKindCity, Area: orb.MultiPolygon{{{{0, 0}, {1, 0}, {1, 1}}}}}},
+		}
+
+		for i, b := range invalid {
+			if _, err := s.domain.boundary.Import(ctx, b); !errors.Is(err, errx.ErrorInvalidBoundary) {
+				t.Fatalf("case %d: expected ErrorInvalidBoundary, got %v", i, err)
+			}
+		}
+	})
+
+	t.Run("import replaces known boundaries", func(t *testing.T) {
+		// Подол расширен до всего Киева
+		wide := models.Boundary{
+			ID:     podilID,
+			Kind:   enum.BoundaryKindDistrict,
+			CityID: &kyivID,
+			Name:   "Podil",
+			Area:   orb.MultiPolygon{{{{30.3, 50.3}, {30.8, 50.3}, {30.8, 50.6}, {30.3, 50.6}, {30.3, 50.3}}}},
+		}
+		report, err := s.domain.boundary.Import(ctx, []models.Boundary{wide})
+		if err != nil {
+			t.Fatalf("Import: %v", err)
+		}
+		if report.Districts != 1 {
+			t.Fatalf("expected 1 district, got %+v", report)
+		}
+
+		res, err := s.domain.place.Filter(ctx, enum.LocaleEN, place.FilterParams{DistrictID: &podilID}, place.SortParams{}, 1, 10)
+		if err != nil {
+			t.Fatalf("Filter: %v", err)
+		}
+		if res.Total != 2 {
+			t.Fatalf("expected 2 places in the wider Podil, got %v", idsOf(res.Data))
+		}
+	})
+}
@@ -0,0 +1,175 @@
+package domain_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/chains-lab/places-svc/internal/data"
+	"github.com/chains-lab/places-svc/internal/domain/enum"
+	"github.com/chains-lab/places-svc/internal/domain/services/pimport"
+	"github.com/chains-lab/places-svc/internal/domain/services/place"
+	"github.com/chains-lab/places-svc/test"
+	"github.com/google/uuid"
+)
+
+func TestPlacesImport(t *testing.T) {
+	s, err := newSetup(t)
+	if err != nil {
+		t.Fatalf("newSetup: %v", err)
+	}
+	test.CleanDB(t)
+
+	ctx := context.Background()
+
+	CreateClass(s, t, "Food", "food", nil)
+	cityID := uuid.New()
+	companyID := uuid.New()
+
+	csvFile := fmt.Sprintf(`city_id,company_id,class,lon,lat,locale,name,description,name_ru,timetable
+%[1]s,%[2]s,food,30.5,50.4,en,Pizza Napoli,Wood-fired pizza,Пицца Наполи,"Mo 09:00-18:00; Fr 22:00-02:00"
+%[1]s,%[2]s,bakery,30.5,50.4,en,Bakery,Bread,,
+%[1]s,%[2]s,food,abc,50.4,en,Broken,Broken,,
+%[1]s,%[2]s,food,30.5,50.4,xx,Unknown locale,Cafe,,
+`, cityID, companyID)
+
+	countPlaces := func() uint64 {
+		t.Helper()
+		res, err := s.domain.place.Filter(ctx, enum.LocaleEN, place.FilterParams{CityID: &cityID}, place.SortParams{}, 1, 10)
+		if err != nil {
+			t.Fatalf("Filter: %v", err)
+		}
+		return res.Total
+	}
+
+	decode := func(file string) []pimport.Row {
+		t.Helper()
+		rows, err := pimport.DecodeCSV(strings.NewReader(file))
+		if err != nil {
+			t.Fatalf("DecodeCSV: %v", err)
+		}
+		return rows
+	}
+
+	t.Run("dry run stores nothing", func(t *testing.T) {
+		report, err := s.domain.pimport.Import(ctx, decode(csvFile), pimport.Params{DryRun: true})
+		if err != nil {
+			t.Fatalf("Import: %v", err)
+		}
+		if !report.DryRun || report.Created != 1 || report.Failed != 3 {
+			t.Fatalf("expected 1 created and 3 failed, got %+v", report)
+		}
+		if n := countPlaces(); n != 0 {
+			t.Fatalf("expected no places after a dry run, got %d", n)
+		}
+	})
+
+	var imported uuid.UUID
+	t.Run("per row report", func(t *testing.T) {
+		report, err := s.domain.pimport.Import(ctx, decode(csvFile), pimport.Params{})
+		if err != nil {
+			t.Fatalf("Import: %v", err)
+		}
+		if len(report.Rows) != 4 {
+			t.Fatalf("expected 4 rows, got %d", len(report.Rows))
+		}
+
+		for i, want := range []string{pimport.ResultCreated, pimport.ResultFailed, pimport.ResultFailed, pimport.ResultFailed} {
+			row := report.Rows[i]
+			if row.Row != i+2 || row.Result != want {
+				t.Fatalf("row %d: expected line %d %s, got line %d %s (%s)", i, i+2, want, row.Row, row.Result, row.Reason)
+			}
+			if want == pimport.ResultFailed && row.Reason == "" {
+				t.Fatalf("row %d: expected a reason", i)
+			}
+		}
+		if !strings.Contains(report.Rows[1].Reason, "bakery") {
+			t.Fatalf("expected the unknown class in the reason, got %q", report.Rows[1].Reason)
+		}
+		imported = *report.Rows[0].PlaceID
+
+		p, err := s.domain.place.Get(ctx, imported, enum.LocaleRU)
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		if p.Name != "Пицца Наполи" || p.CompanyID == nil || *p.CompanyID != companyID {
+			t.Fatalf("unexpected imported place %+v", p)
+		}
+
+		tt, err := s.domain.timetable.GetForPlace(ctx, imported)
+		if err != nil {
+			t.Fatalf("GetForPlace: %v", err)
+		}
+		if len(tt.Table) != 2 {
+			t.Fatalf("expected 2 timetable intervals, got %d", len(tt.Table))
+		}
+	})
+
+	t.Run("update by id", func(t *testing.T) {
+		file := fmt.Sprintf("id,class,lon,lat,locale,name,description\n%s,food,30.6,50.5,en,Pizza Roma,Pizza\n", imported)
+		report, err := s.domain.pimport.Import(ctx, decode(file), pimport.Params{CompanyID: &companyID})
+		if err != nil {
+			t.Fatalf("Import: %v", err)
+		}
+		if report.Updated != 1 {
+			t.Fatalf("expected 1 updated, got %+v", report.Rows)
+		}
+
+		p, err := s.domain.place.Get(ctx, imported, enum.LocaleEN)
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		if p.Name != "Pizza Roma" || p.Point[0] != 30.6 {
+			t.Fatalf("expected the place to be updated, got %q at %v", p.Name, p.Point)
+		}
+		if n := countPlaces(); n != 1 {
+			t.Fatalf("expected 1 place, got %d", n)
+		}
+	})
+
+	t.Run("same point keeps the address", func(t *testing.T) {
+		pg, err := sql.Open("postgres", test.TestDatabaseURL)
+		if err != nil {
+			t.Fatalf("sql.Open: %v", err)
+		}
+		defer pg.Close()
+
+		enricher := place.NewService(data.New(pg), streetGuesser{street: map[string]string{
+			enum.LocaleEN: "Khreshchatyk Street",
+		}})
+		if _, err = enricher.EnrichAddresses(ctx, 10); err != nil {
+			t.Fatalf("EnrichAddresses: %v", err)
+		}
+
+		file := fmt.Sprintf("id,class,lon,lat,locale,name,description\n%s,food,30.6,50.5,en,Pizza Roma,Thin pizza\n", imported)
+		report, err := s.domain.pimport.Import(ctx, decode(file), pimport.Params{CompanyID: &companyID})
+		if err != nil {
+			t.Fatalf("Import: %v", err)
+		}
+		if report.Updated != 1 {
+			t.Fatalf("expected 1 updated, got %+v", report.Rows)
+		}
+
+		p, err := s.domain.place.Get(ctx, imported, enum.LocaleEN)
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		if p.AddressStatus != enum.PlaceAddressStatusResolved || p.AddressDetails == nil {
+			t.Fatalf("expected the resolved address to stay, got %q %+v", p.AddressStatus, p.AddressDetails)
+		}
+	})
+
+	t.Run("other company", func(t *testing.T) {
+		other := uuid.New()
+		file := fmt.Sprintf("id,class,lon,lat,locale,name,description\n%s,food,30.6,50.5,en,Stolen,Pizza\n", imported)
+		report, err := s.domain.pimport.Import(ctx, decode(file), pimport.Params{CompanyID: &other})
+		if err != nil {
+			t.Fatalf("Import: %v", err)
+		}
+		if report.Failed != 1 {
+			t.Fatalf("expected the row to fail, got %+v", report.Rows)
+		}
+	})
+}
@@ -1,16 +1,31 @@
 package domain_test
 
 import (
+	"bytes"
 	"context"
+	"database/sql"
+	"encoding/json"
 	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/chains-lab/places-svc/internal/data"
+	"github.com/chains-lab/places-svc/internal/data/pgdb"
 	"github.com/chains-lab/places-svc/internal/domain/enum"
 	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/infra/geo"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/chains-lab/places-svc/internal/domain/services/class"
 	"github.com/chains-lab/places-svc/internal/domain/services/place"
 	"github.com/chains-lab/places-svc/internal/domain/services/plocale"
 	"github.com/chains-lab/places-svc/test"
 	"github.com/google/uuid"
+	"github.com/paulmach/orb"
 )
 
 func TestPlace(t *testing.T) {
@@ -300,3 +315,1348 @@ func TestPlaceLocales(t *testing.T) {
 		}
 	}
 }
+
+func TestPlaceSearch(t *testing.T) {
+	s, err := newSetup(t)
+	if err != nil {
+		t.Fatalf("newSetup: %v", err)
+	}
+	test.CleanDB(t)
+
+	ctx := context.Background()
+
+	FoodClass := CreateClass(s, t, "Food", "food", nil)
+	cityID := uuid.New()
+
+	pizzeria := CreatePlace(s, t, place.CreateParams{
+		CityID:      cityID,
+		Class:       FoodClass.Code,
+		Point:       [2]float64{30.0, 50.0},
+		Locale:      enum.LocaleEN,
+		Name:        "Pizza Napoli",
+		Address:     "1 Main St",
+		Description: "Wood-fired pizzas and pasta",
+	})
+
+	cafe := CreatePlace(s, t, place.CreateParams{
+		CityID:      cityID,
+		Class:       FoodClass.Code,
+		Point:       [2]float64{30.1, 50.1},
+		Locale:      enum.LocaleEN,
+		Name:        "Morning Cafe",
+		Address:     "2 Main St",
+		Description: "Coffee, croissants and a slice of pizza",
+	})
+
+	_ = CreatePlace(s, t, place.CreateParams{
+		CityID:      cityID,
+		Class:       FoodClass.Code,
+		Point:       [2]float64{30.2, 50.2},
+		Locale:      enum.LocaleEN,
+		Name:        "Book Store",
+		Address:     "3 Main St",
+		Description: "Novels and magazines",
+	})
+
+	err = s.domain.plocale.SetForPlace(ctx, cafe.ID, plocale.SetParams{
+		Locale:      enum.LocaleRU,
+		Name:        "Утреннее кафе",
+		Description: "Кофе и свежие круассаны",
+	})
+	if err != nil {
+		t.Fatalf("SetPlaceLocales: %v", err)
+	}
+
+	search := func(locale, q string, highlight bool) []models.Place {
+		t.Helper()
+		res, err := s.domain.place.Filter(ctx, locale, place.FilterParams{
+			Search: &place.FilterSearch{Query: q, Highlight: highlight},
+		}, place.SortParams{}, 1, 10)
+		if err != nil {
+			t.Fatalf("Filter(q=%q): %v", q, err)
+		}
+		if int(res.Total) != len(res.Data) {
+			t.Fatalf("Filter(q=%q): total %d, got %d rows", q, res.Total, len(res.Data))
+		}
+		return res.Data
+	}
+
+	t.Run("name match ranks above description match", func(t *testing.T) {
+		got := search(enum.LocaleEN, "pizza", false)
+		if len(got) != 2 {
+			t.Fatalf("expected 2 places, got %d", len(got))
+		}
+		if got[0].ID != pizzeria.ID || got[1].ID != cafe.ID {
+			t.Fatalf("expected pizzeria then cafe, got %v", idsOf(got))
+		}
+		for _, p := range got {
+			if p.Rank == nil || *p.Rank <= 0 {
+				t.Fatalf("expected positive rank for %s", p.ID)
+			}
+			if p.Snippet != nil {
+				t.Fatalf("expected no snippet without highlight, got %q", *p.Snippet)
+			}
+		}
+		if *got[0].Rank < *got[1].Rank {
+			t.Fatalf("expected descending rank, got %v then %v", *got[0].Rank, *got[1].Rank)
+		}
+	})
+
+	t.Run("russian stemming", func(t *testing.T) {
+		got := search(enum.LocaleRU, "круассан", true)
+		if len(got) != 1 || got[0].ID != cafe.ID {
+			t.Fatalf("expected only the cafe, got %v", idsOf(got))
+		}
+		if got[0].Name != "Утреннее кафе" {
+			t.Fatalf("expected russian name, got %q", got[0].Name)
+		}
+		if got[0].Snippet == nil || !strings.Contains(*got[0].Snippet, "<b>") {
+			t.Fatalf("expected highlighted snippet, got %v", got[0].Snippet)
+		}
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		if got := search(enum.LocaleEN, "sushi", false); len(got) != 0 {
+			t.Fatalf("expected no places, got %v", idsOf(got))
+		}
+	})
+
+	t.Run("name like", func(t *testing.T) {
+		name := "napoli"
+		res, err := s.domain.place.Filter(ctx, enum.LocaleEN, place.FilterParams{Name: &name}, place.SortParams{}, 1, 10)
+		if err != nil {
+			t.Fatalf("Filter: %v", err)
+		}
+		if len(res.Data) != 1 || res.Data[0].ID != pizzeria.ID {
+			t.Fatalf("expected only the pizzeria, got %v", idsOf(res.Data))
+		}
+		if res.Data[0].Rank != nil {
+			t.Fatalf("expected no rank outside of search")
+		}
+	})
+}
+
+// TestSearchConfigs checks the configurations of the search query against the ones the search column is built with.
+func TestSearchConfigs(t *testing.T) {
+	pg, err := sql.Open("postgres", test.TestDatabaseURL)
+	if err != nil {
+		t.Fatalf("sql.Open: %v", err)
+	}
+	defer pg.Close()
+
+	for _, locale := range append([]string{"xx"}, enum.GetAllLocales()...) {
+		var want string
+		if err = pg.QueryRow("SELECT place_search_config($1)::text", locale).Scan(&want); err != nil {
+			t.Fatalf("place_search_config(%s): %v", locale, err)
+		}
+		if got := pgdb.SearchConfig(locale); got != want {
+			t.Fatalf("locale %s: the query is searched with %q, the column is built with %q", locale, got, want)
+		}
+	}
+}
+
+func TestPlaceSuggest(t *testing.T) {
+	s, err := newSetup(t)
+	if err != nil {
+		t.Fatalf("newSetup: %v", err)
+	}
+	test.CleanDB(t)
+
+	ctx := context.Background()
+
+	FoodClass := CreateClass(s, t, "Food", "food", nil)
+	cityID := uuid.New()
+
+	far := CreatePlace(s, t, place.CreateParams{
+		CityID:      cityID,
+		Class:       FoodClass.Code,
+		Point:       [2]float64{30.50, 50.50},
+		Locale:      enum.LocaleEN,
+		Name:        "Pizzeria Roma",
+		Address:     "1 Main St",
+		Description: "Pizza",
+	})
+
+	near := CreatePlace(s, t, place.CreateParams{
+		CityID:      cityID,
+		Class:       FoodClass.Code,
+		Point:       [2]float64{30.00, 50.00},
+		Locale:      enum.LocaleEN,
+		Name:        "Pizzeria Milano",
+		Address:     "2 Main St",
+		Description: "Pizza",
+	})
+
+	closed := CreatePlace(s, t, place.CreateParams{
+		CityID:      cityID,
+		Class:       FoodClass.Code,
+		Point:       [2]float64{30.00, 50.00},
+		Locale:      enum.LocaleEN,
+		Name:        "Pizzeria Closed",
+		Address:     "3 Main St",
+		Description: "Pizza",
+	})
+	if _, err = s.domain.place.UpdateStatus(ctx, closed.ID, enum.LocaleEN, enum.PlaceStatusInactive); err != nil {
+		t.Fatalf("UpdateStatus: %v", err)
+	}
+
+	err = s.domain.plocale.SetForPlace(ctx, near.ID, plocale.SetParams{
+		Locale:      enum.LocaleUK,
+		Name:        "Піцерія Мілано",
+		Description: "Піца",
+	})
+	if err != nil {
+		t.Fatalf("SetPlaceLocales: %v", err)
+	}
+
+	suggestIDs := func(res []models.PlaceSuggestion) []uuid.UUID {
+		ids := make([]uuid.UUID, 0, len(res))
+		for _, r := range res {
+			ids = append(ids, r.ID)
+		}
+		return ids
+	}
+
+	t.Run("prefix", func(t *testing.T) {
+		res, err := s.domain.place.Suggest(ctx, enum.LocaleEN, place.SuggestParams{Query: "pizz"})
+		if err != nil {
+			t.Fatalf("Suggest: %v", err)
+		}
+		if len(res) != 2 {
+			t.Fatalf("expected 2 active places, got %v", suggestIDs(res))
+		}
+		if containsID(suggestIDs(res), closed.ID) {
+			t.Fatalf("inactive place must not be suggested")
+		}
+		if res[0].DistanceM != nil {
+			t.Fatalf("expected no distance without point")
+		}
+	})
+
+	t.Run("misspelled", func(t *testing.T) {
+		res, err := s.domain.place.Suggest(ctx, enum.LocaleEN, place.SuggestParams{Query: "milanno"})
+		if err != nil {
+			t.Fatalf("Suggest: %v", err)
+		}
+		if len(res) == 0 || res[0].ID != near.ID {
+			t.Fatalf("expected Pizzeria Milano first, got %v", suggestIDs(res))
+		}
+	})
+
+	t.Run("preferred locale", func(t *testing.T) {
+		res, err := s.domain.place.Suggest(ctx, enum.LocaleUK, place.SuggestParams{Query: "Піцер"})
+		if err != nil {
+			t.Fatalf("Suggest: %v", err)
+		}
+		if len(res) != 1 || res[0].ID != near.ID {
+			t.Fatalf("expected only Pizzeria Milano, got %v", suggestIDs(res))
+		}
+		if res[0].Locale != enum.LocaleUK || res[0].Name != "Піцерія Мілано" {
+			t.Fatalf("expected ukrainian name, got %s %q", res[0].Locale, res[0].Name)
+		}
+	})
+
+	t.Run("near point", func(t *testing.T) {
+		pt := orb.Point{30.00, 50.00}
+		res, err := s.domain.place.Suggest(ctx, enum.LocaleEN, place.SuggestParams{Query: "pizzeria", Point: &pt, Limit: 1})
+		if err != nil {
+			t.Fatalf("Suggest: %v", err)
+		}
+		if len(res) != 1 || res[0].ID != near.ID {
+			t.Fatalf("expected the nearby pizzeria, got %v", suggestIDs(res))
+		}
+		if res[0].DistanceM == nil || *res[0].DistanceM > 1 {
+			t.Fatalf("expected distance close to 0, got %v", res[0].DistanceM)
+		}
+
+		pt = orb.Point{30.50, 50.50}
+		res, err = s.domain.place.Suggest(ctx, enum.LocaleEN, place.SuggestParams{Query: "pizzeria", Point: &pt, Limit: 1})
+		if err != nil {
+			t.Fatalf("Suggest: %v", err)
+		}
+		if len(res) != 1 || res[0].ID != far.ID {
+			t.Fatalf("expected the other pizzeria, got %v", suggestIDs(res))
+		}
+	})
+}
+
+func TestPlaceArea(t *testing.T) {
+	s, err := newSetup(t)
+	if err != nil {
+		t.Fatalf("newSetup: %v", err)
+	}
+	test.CleanDB(t)
+
+	ctx := context.Background()
+
+	FoodClass := CreateClass(s, t, "Food", "food", nil)
+	cityID := uuid.New()
+
+	newPlace := func(name string, lon, lat float64) models.Place {
+		return CreatePlace(s, t, place.CreateParams{
+			CityID:      cityID,
+			Class:       FoodClass.Code,
+			Point:       [2]float64{lon, lat},
+			Locale:      enum.LocaleEN,
+			Name:        name,
+			Address:     "Main St",
+			Description: name,
+		})
+	}
+
+	kyiv := newPlace("Kyiv", 30.52, 50.45)
+	fiji := newPlace("Fiji", 178.44, -18.14)
+	samoa := newPlace("Samoa", -171.77, -13.83)
+
+	filter := func(f place.FilterParams) []uuid.UUID {
+		t.Helper()
+		res, err := s.domain.place.Filter(ctx, enum.LocaleEN, f, place.SortParams{}, 1, 10)
+		if err != nil {
+			t.Fatalf("Filter: %v", err)
+		}
+		return idsOf(res.Data)
+	}
+
+	t.Run("bbox", func(t *testing.T) {
+		got := filter(place.FilterParams{BBox: &models.BBox{MinLon: 20, MinLat: 40, MaxLon: 40, MaxLat: 60}})
+		if len(got) != 1 || got[0] != kyiv.ID {
+			t.Fatalf("expected only Kyiv, got %v", got)
+		}
+	})
+
+	t.Run("bbox over antimeridian", func(t *testing.T) {
+		got := filter(place.FilterParams{BBox: &models.BBox{MinLon: 170, MinLat: -30, MaxLon: -165, MaxLat: 0}})
+		if len(got) != 2 || !containsID(got, fiji.ID) || !containsID(got, samoa.ID) {
+			t.Fatalf("expected Fiji and Samoa, got %v", got)
+		}
+	})
+
+	t.Run("polygon", func(t *testing.T) {
+		area := orb.Polygon{{{20, 40}, {40, 40}, {40, 60}, {20, 60}, {20, 40}}}
+		got := filter(place.FilterParams{Area: area})
+		if len(got) != 1 || got[0] != kyiv.ID {
+			t.Fatalf("expected only Kyiv, got %v", got)
+		}
+	})
+
+	t.Run("polygon over antimeridian", func(t *testing.T) {
+		wrapped := orb.Polygon{{{170, -30}, {-165, -30}, {-165, 0}, {170, 0}, {170, -30}}}
+		unwrapped := orb.Polygon{{{170, -30}, {195, -30}, {195, 0}, {170, 0}, {170, -30}}}
+
+		for _, area := range []orb.Polygon{wrapped, unwrapped} {
+			got := filter(place.FilterParams{Area: area})
+			if len(got) != 2 || !containsID(got, fiji.ID) || !containsID(got, samoa.ID) {
+				t.Fatalf("expected Fiji and Samoa, got %v", got)
+			}
+		}
+	})
+
+	t.Run("invalid areas", func(t *testing.T) {
+		invalid := []place.FilterParams{
+			{BBox: &models.BBox{MinLon: 20, MinLat: 60, MaxLon: 40, MaxLat: 40}},
+			{BBox: &models.BBox{MinLon: 20, MinLat: 40, MaxLon: 200, MaxLat: 60}},
+			{Area: orb.Polygon{{{0, 0}, {10, 0}, {10, 10}}}},
+			{Area: orb.Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}}}},
+			{Area: orb.Polygon{{{0, 0}, {10, 10}, {10, 0}, {0, 10}, {0, 0}}}},
+			{Area: orb.LineString{{0, 0}, {10, 10}}},
+		}
+
+		for i, f := range invalid {
+			_, err := s.domain.place.Filter(ctx, enum.LocaleEN, f, place.SortParams{}, 1, 10)
+			if !errors.Is(err, errx.ErrorInvalidSearchArea) {
+				t.Fatalf("case %d: expected ErrorInvalidSearchArea, got %v", i, err)
+			}
+		}
+	})
+}
+
+func TestPlaceClusters(t *testing.T) {
+	s, err := newSetup(t)
+	if err != nil {
+		t.Fatalf("newSetup: %v", err)
+	}
+	test.CleanDB(t)
+
+	ctx := context.Background()
+
+	FoodClass := CreateClass(s, t, "Food", "food", nil)
+	cityID := uuid.New()
+
+	newPlace := func(name string, lon, lat float64) models.Place {
+		return CreatePlace(s, t, place.CreateParams{
+			CityID:      cityID,
+			Class:       FoodClass.Code,
+			Point:       [2]float64{lon, lat},
+			Locale:      enum.LocaleEN,
+			Name:        name,
+			Address:     "Main St",
+			Description: name,
+		})
+	}
+
+	first := newPlace("First", 30.001, 50.001)
+	second := newPlace("Second", 30.002, 50.002)
+	third := newPlace("Third", 30.003, 50.003)
+	far := newPlace("Far", 31.5, 50.5)
+	_ = newPlace("Outside", 40, 40)
+
+	if _, err = s.domain.place.UpdateStatus(ctx, third.ID, enum.LocaleEN, enum.PlaceStatusInactive); err != nil {
+		t.Fatalf("UpdateStatus: %v", err)
+	}
+
+	bbox := models.BBox{MinLon: 29, MinLat: 49, MaxLon: 32, MaxLat: 51}
+
+	t.Run("grid", func(t *testing.T) {
+		res, err := s.domain.place.Clusters(ctx, place.FilterParams{}, bbox, 10)
+		if err != nil {
+			t.Fatalf("Clusters: %v", err)
+		}
+		if len(res) != 2 {
+			t.Fatalf("expected 2 clusters, got %d", len(res))
+		}
+		if res[0].Count != 3 || res[1].Count != 1 {
+			t.Fatalf("expected clusters of 3 and 1 places, got %d and %d", res[0].Count, res[1].Count)
+		}
+		if len(res[0].PlaceIDs) != 3 || !containsID(res[0].PlaceIDs, first.ID) || !containsID(res[0].PlaceIDs, third.ID) {
+			t.Fatalf("unexpected sample ids %v", res[0].PlaceIDs)
+		}
+		if res[1].PlaceIDs[0] != far.ID {
+			t.Fatalf("expected the far place alone, got %v", res[1].PlaceIDs)
+		}
+		if lon := res[0].Centroid[0]; lon < 30.0019 || lon > 30.0021 {
+			t.Fatalf("unexpected centroid %v", res[0].Centroid)
+		}
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		res, err := s.domain.place.Clusters(ctx, place.FilterParams{
+			Statuses: []string{enum.PlaceStatusActive},
+		}, bbox, 10)
+		if err != nil {
+			t.Fatalf("Clusters: %v", err)
+		}
+		if len(res) != 2 || res[0].Count != 2 {
+			t.Fatalf("expected the inactive place to be excluded, got %+v", res)
+		}
+		if !containsID(res[0].PlaceIDs, second.ID) || containsID(res[0].PlaceIDs, third.ID) {
+			t.Fatalf("unexpected sample ids %v", res[0].PlaceIDs)
+		}
+	})
+
+	t.Run("low zoom", func(t *testing.T) {
+		res, err := s.domain.place.Clusters(ctx, place.FilterParams{}, bbox, 2)
+		if err != nil {
+			t.Fatalf("Clusters: %v", err)
+		}
+		if len(res) != 1 || res[0].Count != 4 {
+			t.Fatalf("expected a single cluster of 4 places, got %+v", res)
+		}
+	})
+}
+
+func TestPlacesTile(t *testing.T) {
+	s, err := newSetup(t)
+	if err != nil {
+		t.Fatalf("newSetup: %v", err)
+	}
+	test.CleanDB(t)
+
+	ctx := context.Background()
+
+	FoodClass := CreateClass(s, t, "Food", "food", nil)
+	ShopsClass := CreateClass(s, t, "Shops", "shops", nil)
+
+	kyiv := CreatePlace(s, t, place.CreateParams{
+		CityID:      uuid.New(),
+		Class:       FoodClass.Code,
+		Point:       [2]float64{30.52, 50.45},
+		Locale:      enum.LocaleEN,
+		Name:        "Kyiv Cafe",
+		Address:     "Main St",
+		Description: "Cafe",
+	})
+
+	tileXY := func(lon, lat float64, z uint32) (uint32, uint32) {
+		n := math.Exp2(float64(z))
+		rad := lat * math.Pi / 180
+		x := (lon + 180) / 360 * n
+		y := (1 - math.Log(math.Tan(rad)+1/math.Cos(rad))/math.Pi) / 2 * n
+		return uint32(x), uint32(y)
+	}
+
+	x, y := tileXY(30.52, 50.45, 10)
+
+	t.Run("tile with the place", func(t *testing.T) {
+		tile, err := s.domain.place.Tile(ctx, enum.LocaleEN, place.FilterParams{}, 10, x, y)
+		if err != nil {
+			t.Fatalf("Tile: %v", err)
+		}
+		if len(tile) == 0 {
+			t.Fatalf("expected a non-empty tile")
+		}
+		if !bytes.Contains(tile, []byte(kyiv.ID.String())) || !bytes.Contains(tile, []byte("Kyiv Cafe")) {
+			t.Fatalf("expected the tile to carry the place id and name")
+		}
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		tile, err := s.domain.place.Tile(ctx, enum.LocaleEN, place.FilterParams{
+			Classes: []string{ShopsClass.Code},
+		}, 10, x, y)
+		if err != nil {
+			t.Fatalf("Tile: %v", err)
+		}
+		if len(tile) != 0 {
+			t.Fatalf("expected an empty tile, got %d bytes", len(tile))
+		}
+	})
+
+	t.Run("empty tile", func(t *testing.T) {
+		tile, err := s.domain.place.Tile(ctx, enum.LocaleEN, place.FilterParams{}, 10, x+5, y)
+		if err != nil {
+			t.Fatalf("Tile: %v", err)
+		}
+		if len(tile) != 0 {
+			t.Fatalf("expected an empty tile, got %d bytes", len(tile))
+		}
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		_, err := s.domain.place.Tile(ctx, enum.LocaleEN, place.FilterParams{}, 2, 4, 0)
+		if !errors.Is(err, errx.ErrorInvalidTile) {
+			t.Fatalf("expected ErrorInvalidTile, got %v", err)
+		}
+	})
+}
+
+func TestPlaceCursorPagination(t *testing.T) {
+	s, err := newSetup(t)
+	if err != nil {
+		t.Fatalf("newSetup: %v", err)
+	}
+	test.CleanDB(t)
+
+	ctx := context.Background()
+
+	FoodClass := CreateClass(s, t, "Food", "food", nil)
+	cityID := uuid.New()
+
+	for i := 0; i < 7; i++ {
+		_ = CreatePlace(s, t, place.CreateParams{
+			CityID:      cityID,
+			Class:       FoodClass.Code,
+			Point:       [2]float64{30.0 + float64(i)*0.01, 50.0},
+			Locale:      enum.LocaleEN,
+			Name:        fmt.Sprintf("Pizza %d", i),
+			Address:     "Main St",
+			Description: "Pizza",
+		})
+	}
+
+	walk := func(filter place.FilterParams, sort place.SortParams, skipTotal bool) []models.Place {
+		t.Helper()
+		var (
+			all    []models.Place
+			cursor string
+		)
+		for pages := 0; ; pages++ {
+			if pages > 10 {
+				t.Fatalf("cursor pagination does not end")
+			}
+			res, err := s.domain.place.FilterByCursor(ctx, enum.LocaleEN, filter, sort, place.CursorParams{
+				Cursor:    cursor,
+				Size:      3,
+				SkipTotal: skipTotal,
+			})
+			if err != nil {
+				t.Fatalf("FilterByCursor: %v", err)
+			}
+			if res.TotalSkipped != skipTotal {
+				t.Fatalf("expected total skipped %v, got %v", skipTotal, res.TotalSkipped)
+			}
+			if !skipTotal && res.Total != 7 {
+				t.Fatalf("expected total 7, got %d", res.Total)
+			}
+			all = append(all, res.Data...)
+			if res.NextCursor == "" {
+				break
+			}
+			cursor = res.NextCursor
+		}
+
+		seen := map[uuid.UUID]bool{}
+		for _, p := range all {
+			if seen[p.ID] {
+				t.Fatalf("place %s returned twice", p.ID)
+			}
+			seen[p.ID] = true
+		}
+		if len(all) != 7 {
+			t.Fatalf("expected 7 places, got %d", len(all))
+		}
+		return all
+	}
+
+	t.Run("created_at", func(t *testing.T) {
+		asc := false
+		got := walk(place.FilterParams{}, place.SortParams{ByCreatedAt: &asc}, false)
+		for i := 1; i < len(got); i++ {
+			if got[i].CreatedAt.After(got[i-1].CreatedAt) {
+				t.Fatalf("expected newest first, got %v", idsOf(got))
+			}
+		}
+	})
+
+	t.Run("distance", func(t *testing.T) {
+		asc := true
+		got := walk(place.FilterParams{
+			Location: &place.FilterDistance{Point: [2]float64{30.0, 50.0}, RadiusM: 100000},
+		}, place.SortParams{ByDistance: &asc}, true)
+		for i, p := range got {
+			if p.Name != fmt.Sprintf("Pizza %d", i) {
+				t.Fatalf("expected the nearest first, got %q at %d", p.Name, i)
+			}
+		}
+	})
+
+	t.Run("relevance", func(t *testing.T) {
+		walk(place.FilterParams{Search: &place.FilterSearch{Query: "pizza"}}, place.SortParams{}, false)
+	})
+
+	t.Run("cursor of another order", func(t *testing.T) {
+		res, err := s.domain.place.FilterByCursor(ctx, enum.LocaleEN, place.FilterParams{}, place.SortParams{},
+			place.CursorParams{Size: 3})
+		if err != nil {
+			t.Fatalf("FilterByCursor: %v", err)
+		}
+
+		asc := true
+		_, err = s.domain.place.FilterByCursor(ctx, enum.LocaleEN, place.FilterParams{},
+			place.SortParams{ByCreatedAt: &asc}, place.CursorParams{Cursor: res.NextCursor, Size: 3})
+		if !errors.Is(err, errx.ErrorInvalidPlacesCursor) {
+			t.Fatalf("expected ErrorInvalidPlacesCursor, got %v", err)
+		}
+
+		_, err = s.domain.place.FilterByCursor(ctx, enum.LocaleEN, place.FilterParams{}, place.SortParams{},
+			place.CursorParams{Cursor: "not-a-cursor", Size: 3})
+		if !errors.Is(err, errx.ErrorInvalidPlacesCursor) {
+			t.Fatalf("expected ErrorInvalidPlacesCursor, got %v", err)
+		}
+	})
+
+	t.Run("numbered page without total", func(t *testing.T) {
+		res, err := s.domain.place.FilterPage(ctx, enum.LocaleEN, place.FilterParams{}, place.SortParams{},
+			place.PageParams{Page: 1, Size: 3, SkipTotal: true})
+		if err != nil {
+			t.Fatalf("FilterPage: %v", err)
+		}
+		if !res.TotalSkipped || res.Total != 0 || len(res.Data) != 3 {
+			t.Fatalf("expected 3 places without total, got %d of %d", len(res.Data), res.Total)
+		}
+	})
+}
+
+func TestPlaceFacets(t *testing.T) {
+	s, err := newSetup(t)
+	if err != nil {
+		t.Fatalf("newSetup: %v", err)
+	}
+	test.CleanDB(t)
+
+	ctx := context.Background()
+
+	FoodClass := CreateClass(s, t, "Food", "food", nil)
+	PizzaClass := CreateClass(s, t, "Pizza", "pizza", &FoodClass.Code)
+	ShopsClass := CreateClass(s, t, "Shops", "shops", nil)
+	cityID := uuid.New()
+
+	newPlace := func(name, class string) models.Place {
+		return CreatePlace(s, t, place.CreateParams{
+			CityID:      cityID,
+			Class:       class,
+			Point:       [2]float64{30.0, 50.0},
+			Locale:      enum.LocaleEN,
+			Name:        name,
+			Address:     "Main St",
+			Description: name,
+		})
+	}
+
+	pizzeria := newPlace("Pizzeria", PizzaClass.Code)
+	closed := newPlace("Closed Pizzeria", PizzaClass.Code)
+	_ = newPlace("Canteen", FoodClass.Code)
+	_ = newPlace("Shop", ShopsClass.Code)
+
+	if _, err = s.domain.place.Verify(ctx, pizzeria.ID, enum.LocaleEN, true); err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	if _, err = s.domain.place.UpdateStatus(ctx, closed.ID, enum.LocaleEN, enum.PlaceStatusInactive); err != nil {
+		t.Fatalf("UpdateStatus: %v", err)
+	}
+
+	counts := func(fs []models.PlaceFacetCount) map[string]uint64 {
+		res := map[string]uint64{}
+		for _, f := range fs {
+			res[f.Value] = f.Count
+		}
+		return res
+	}
+
+	filter := place.FilterParams{
+		Classes:  []string{PizzaClass.Code},
+		Statuses: []string{enum.PlaceStatusActive},
+	}
+
+	t.Run("own constraint is excluded", func(t *testing.T) {
+		facets, err := s.domain.place.Facets(ctx, filter, []string{
+			place.FacetClass, place.FacetStatus, place.FacetVerified, place.FacetOpenNow,
+		})
+		if err != nil {
+			t.Fatalf("Facets: %v", err)
+		}
+
+		class := counts(facets.Class)
+		if class[FoodClass.Code] != 2 || class[PizzaClass.Code] != 1 || class[ShopsClass.Code] != 1 {
+			t.Fatalf("expected food 2, pizza 1, shops 1, got %v", class)
+		}
+
+		status := counts(facets.Status)
+		if status[enum.PlaceStatusActive] != 1 || status[enum.PlaceStatusInactive] != 1 {
+			t.Fatalf("expected active 1, inactive 1, got %v", status)
+		}
+
+		verified := counts(facets.Verified)
+		if verified["true"] != 1 || verified["false"] != 0 {
+			t.Fatalf("expected a single verified place, got %v", verified)
+		}
+
+		if facets.OpenNow == nil {
+			t.Fatalf("expected the open_now count")
+		}
+	})
+
+	t.Run("open now ignores the time window", func(t *testing.T) {
+		// открыто всю неделю, кроме часа через шесть часов от текущего момента
+		at := func(d time.Duration) models.Moment {
+			now := time.Now().UTC()
+			m := (int(now.Weekday())*24*60 + now.Hour()*60 + now.Minute() + int(d.Minutes())) % models.WeekMinutes
+			return models.Moment{Weekday: time.Weekday(m / (24 * 60)), Time: time.Duration(m%(24*60)) * time.Minute}
+		}
+		table := []models.TimeInterval{{From: at(7 * time.Hour), To: at(6 * time.Hour)}}
+		if _, err := s.domain.timetable.SetForPlace(ctx, pizzeria.ID, enum.LocaleEN, models.Timetable{Table: table}); err != nil {
+			t.Fatalf("SetForPlace: %v", err)
+		}
+
+		closedWindow := filter
+		closedWindow.Time = &models.TimeInterval{From: at(6*time.Hour + 10*time.Minute), To: at(6*time.Hour + 50*time.Minute)}
+
+		res, err := s.domain.place.Filter(ctx, enum.LocaleEN, closedWindow, place.SortParams{}, 1, 10)
+		if err != nil {
+			t.Fatalf("Filter: %v", err)
+		}
+		if res.Total != 0 {
+			t.Fatalf("expected no place open in the window, got %v", idsOf(res.Data))
+		}
+
+		facets, err := s.domain.place.Facets(ctx, closedWindow, []string{place.FacetOpenNow})
+		if err != nil {
+			t.Fatalf("Facets: %v", err)
+		}
+		if facets.OpenNow == nil || *facets.OpenNow != 1 {
+			t.Fatalf("expected the pizzeria open now, got %v", facets.OpenNow)
+		}
+	})
+
+	t.Run("only requested facets", func(t *testing.T) {
+		facets, err := s.domain.place.Facets(ctx, filter, []string{place.FacetStatus})
+		if err != nil {
+			t.Fatalf("Facets: %v", err)
+		}
+		if facets.Status == nil || facets.Class != nil || facets.Verified != nil || facets.OpenNow != nil {
+			t.Fatalf("expected only the status facet, got %+v", facets)
+		}
+	})
+
+	t.Run("unknown facet", func(t *testing.T) {
+		_, err := s.domain.place.Facets(ctx, filter, []string{"color"})
+		if !errors.Is(err, errx.ErrorInvalidPlaceFacet) {
+			t.Fatalf("expected ErrorInvalidPlaceFacet, got %v", err)
+		}
+	})
+}
+
+func TestPlacesExport(t *testing.T) {
+	s, err := newSetup(t)
+	if err != nil {
+		t.Fatalf("newSetup: %v", err)
+	}
+	test.CleanDB(t)
+
+	ctx := context.Background()
+
+	FoodClass := CreateClass(s, t, "Food", "food", nil)
+	cityID := uuid.New()
+	companyID := uuid.New()
+
+	newPlace := func(name string, company *uuid.UUID) models.Place {
+		return CreatePlace(s, t, place.CreateParams{
+			CityID:        cityID,
+			DistributorID: company,
+			Class:         FoodClass.Code,
+			Point:         [2]float64{30.0, 50.0},
+			Locale:        enum.LocaleEN,
+			Name:          name,
+			Address:       "Main St",
+			Description:   name,
+		})
+	}
+
+	cafe := newPlace("Cafe", &companyID)
+	_ = newPlace("Canteen", &companyID)
+	_ = newPlace("Other", nil)
+
+	err = s.domain.plocale.SetForPlace(ctx, cafe.ID, plocale.SetParams{
+		Locale:      enum.LocaleUK,
+		Name:        "Кав'ярня",
+		Description: "Кава",
+	})
+	if err != nil {
+		t.Fatalf("SetForPlace: %v", err)
+	}
+
+	tt := models.Timetable{Table: []models.TimeInterval{{
+		From: models.Moment{Weekday: time.Monday, Time: 9 * time.Hour},
+		To:   models.Moment{Weekday: time.Monday, Time: 18 * time.Hour},
+	}}}
+	if _, err = s.domain.timetable.SetForPlace(ctx, cafe.ID, enum.LocaleEN, tt); err != nil {
+		t.Fatalf("SetForPlace timetable: %v", err)
+	}
+
+	export := func(filter place.FilterParams) []models.PlaceExport {
+		t.Helper()
+		var res []models.PlaceExport
+		err := s.domain.place.Export(ctx, filter, func(p models.PlaceExport) error {
+			res = append(res, p)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("Export: %v", err)
+		}
+		return res
+	}
+
+	t.Run("all places ordered by id", func(t *testing.T) {
+		res := export(place.FilterParams{CityID: &cityID})
+		if len(res) != 3 {
+			t.Fatalf("expected 3 places, got %d", len(res))
+		}
+		for i := 1; i < len(res); i++ {
+			if bytes.Compare(res[i-1].ID[:], res[i].ID[:]) >= 0 {
+				t.Fatalf("expected places ordered by id, got %s before %s", res[i-1].ID, res[i].ID)
+			}
+		}
+	})
+
+	t.Run("locales and timetable", func(t *testing.T) {
+		res := export(place.FilterParams{CompanyID: &companyID, Name: &cafe.Name})
+		if len(res) != 1 || res[0].ID != cafe.ID {
+			t.Fatalf("expected the cafe only, got %d places", len(res))
+		}
+
+		got := res[0]
+		if len(got.Locales) != 2 || got.Locales[0].Locale != enum.LocaleEN || got.Locales[1].Name != "Кав'ярня" {
+			t.Fatalf("expected en and uk locales, got %+v", got.Locales)
+		}
+		if len(got.Timetable.Table) != 1 || got.Timetable.Table[0].From != tt.Table[0].From {
+			t.Fatalf("expected the timetable, got %+v", got.Timetable.Table)
+		}
+	})
+
+	t.Run("company filter", func(t *testing.T) {
+		if res := export(place.FilterParams{CompanyID: &companyID}); len(res) != 2 {
+			t.Fatalf("expected 2 places of the company, got %d", len(res))
+		}
+	})
+
+	t.Run("callback error stops the export", func(t *testing.T) {
+		stop := errors.New("stop")
+		calls := 0
+		err := s.domain.place.Export(ctx, place.FilterParams{CityID: &cityID}, func(models.PlaceExport) error {
+			calls++
+			return stop
+		})
+		if !errors.Is(err, stop) || calls != 1 {
+			t.Fatalf("expected the export to stop after 1 place, got %d calls and %v", calls, err)
+		}
+	})
+}
+
+// streetGuesser geocodes every point to the same street, named in the requested locale.
+type streetGuesser struct {
+	street map[string]string
+}
+
+func (g streetGuesser) Guess(_ context.Context, _ orb.Point, locale string) (models.PlaceAddress, error) {
+	return models.PlaceAddress{
+		Formatted:   g.street[locale] + " 1, Kyiv, 01001, Ukraine",
+		Country:     "Ukraine",
+		CountryCode: "UA",
+		City:        "Kyiv",
+		Street:      g.street[locale],
+		HouseNumber: "1",
+		Postcode:    "01001",
+	}, nil
+}
+
+func (g streetGuesser) Locate(context.Context, string, string) ([]models.GeoCandidate, error) {
+	return nil, nil
+}
+
+// emptyGuesser is a geocoder that knows no address of any point.
+type emptyGuesser struct{}
+
+func (emptyGuesser) Guess(context.Context, orb.Point, string) (models.PlaceAddress, error) {
+	return models.PlaceAddress{}, nil
+}
+
+func (emptyGuesser) Locate(context.Context, string, string) ([]models.GeoCandidate, error) {
+	return nil, nil
+}
+
+// failingGuesser is a geocoder that is down.
+type failingGuesser struct{}
+
+func (failingGuesser) Guess(context.Context, orb.Point, string) (models.PlaceAddress, error) {
+	return models.PlaceAddress{}, errors.New("geocoder is unavailable")
+}
+
+func (failingGuesser) Locate(context.Context, string, string) ([]models.GeoCandidate, error) {
+	return nil, errors.New("geocoder is unavailable")
+}
+
+func TestPlaceAddress(t *testing.T) {
+	test.CleanDB(t)
+
+	pg, err := sql.Open("postgres", test.TestDatabaseURL)
+	if err != nil {
+		t.Fatalf("sql.Open: %v", err)
+	}
+	defer pg.Close()
+
+	ctx := context.Background()
+	database := data.New(pg)
+
+	if _, err = class.NewService(database).Create(ctx, class.CreateParams{Code: "food", Name: "Food", Icon: "food"}); err != nil {
+		t.Fatalf("CreateClass: %v", err)
+	}
+
+	svc := place.NewService(database, streetGuesser{street: map[string]string{
+		enum.LocaleEN: "Khreshchatyk Street",
+		enum.LocaleUK: "вулиця Хрещатик",
+	}})
+
+	cafe, err := svc.Create(ctx, place.CreateParams{
+		CityID:      uuid.New(),
+		Class:       "food",
+		Point:       orb.Point{30.52, 50.45},
+		Locale:      enum.LocaleUK,
+		Name:        "Кав'ярня",
+		Description: "Кава",
+	})
+	if err != nil {
+		t.Fatalf("Create: %v", err)
+	}
+
+	t.Run("looked up after create", func(t *testing.T) {
+		if cafe.AddressStatus != enum.PlaceAddressStatusPending || cafe.AddressDetails != nil {
+			t.Fatalf("expected a pending address, got %q %+v", cafe.AddressStatus, cafe.AddressDetails)
+		}
+
+		report, err := svc.EnrichAddresses(ctx, 10)
+		if err != nil {
+			t.Fatalf("EnrichAddresses: %v", err)
+		}
+		if report.Claimed != 1 || report.Resolved != 1 {
+			t.Fatalf("expected one resolved place, got %+v", report)
+		}
+
+		got, err := svc.Get(ctx, cafe.ID, enum.LocaleUK)
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		if got.AddressStatus != enum.PlaceAddressStatusResolved {
+			t.Fatalf("expected a resolved address, got %q", got.AddressStatus)
+		}
+		if got.Address != "вулиця Хрещатик 1, Kyiv, 01001, Ukraine" {
+			t.Fatalf("expected the formatted address, got %q", got.Address)
+		}
+		if got.AddressDetails == nil {
+			t.Fatalf("expected address details")
+		}
+		if got.AddressDetails.Street != "вулиця Хрещатик" || got.AddressDetails.CountryCode != "ua" {
+			t.Fatalf("unexpected address %+v", got.AddressDetails)
+		}
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		str := func(s string) *string { return &s }
+
+		for _, tc := range []struct {
+			name   string
+			filter place.FilterParams
+			want   uint64
+		}{
+			{"country code in any case", place.FilterParams{CountryCode: str("UA")}, 1},
+			{"other country", place.FilterParams{CountryCode: str("pl")}, 0},
+			{"postcode with spaces", place.FilterParams{Postcode: str("01 001")}, 1},
+			{"street part", place.FilterParams{Street: str("хрещ")}, 1},
+			{"other street", place.FilterParams{Street: str("Sahaidachnoho")}, 0},
+		} {
+			res, err := svc.Filter(ctx, enum.LocaleEN, tc.filter, place.SortParams{}, 1, 10)
+			if err != nil {
+				t.Fatalf("%s: Filter: %v", tc.name, err)
+			}
+			if res.Total != tc.want {
+				t.Fatalf("%s: expected %d places, got %d", tc.name, tc.want, res.Total)
+			}
+		}
+	})
+
+	t.Run("moved point is geocoded again", func(t *testing.T) {
+		moved := orb.Point{30.53, 50.46}
+		svc = place.NewService(database, streetGuesser{street: map[string]string{
+			enum.LocaleUK: "вулиця Інститутська",
+		}})
+
+		res, err := svc.Update(ctx, cafe.ID, enum.LocaleUK, place.UpdateParams{Point: &moved})
+		if err != nil {
+			t.Fatalf("Update: %v", err)
+		}
+		if res.AddressStatus != enum.PlaceAddressStatusPending || res.AddressDetails != nil {
+			t.Fatalf("expected the address to be looked up again, got %q %+v", res.AddressStatus, res.AddressDetails)
+		}
+
+		if _, err = svc.EnrichAddresses(ctx, 10); err != nil {
+			t.Fatalf("EnrichAddresses: %v", err)
+		}
+
+		got, err := svc.Get(ctx, cafe.ID, enum.LocaleUK)
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		if got.AddressDetails == nil || got.AddressDetails.Street != "вулиця Інститутська" {
+			t.Fatalf("expected the stored street to change, got %+v", got.AddressDetails)
+		}
+	})
+
+	t.Run("cached point", func(t *testing.T) {
+		svc = place.NewService(database, failingGuesser{})
+
+		// та же точка с точностью до метра уже есть в кэше
+		neighbour, err := svc.Create(ctx, place.CreateParams{
+			CityID:      uuid.New(),
+			Class:       "food",
+			Point:       orb.Point{30.530001, 50.460001},
+			Locale:      enum.LocaleUK,
+			Name:        "Пекарня",
+			Description: "Хліб",
+		})
+		if err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+
+		report, err := svc.EnrichAddresses(ctx, 10)
+		if err != nil {
+			t.Fatalf("EnrichAddresses: %v", err)
+		}
+		if report.Resolved != 1 {
+			t.Fatalf("expected the address from the cache, got %+v", report)
+		}
+
+		got, err := svc.Get(ctx, neighbour.ID, enum.LocaleUK)
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		if got.AddressDetails == nil || got.AddressDetails.Street != "вулиця Інститутська" {
+			t.Fatalf("expected the cached street, got %+v", got.AddressDetails)
+		}
+	})
+
+	t.Run("failed lookup is retried later", func(t *testing.T) {
+		svc = place.NewService(database, failingGuesser{})
+
+		far, err := svc.Create(ctx, place.CreateParams{
+			CityID:      uuid.New(),
+			Class:       "food",
+			Point:       orb.Point{24.03, 49.84},
+			Locale:      enum.LocaleUK,
+			Name:        "Кнайпа",
+			Description: "Пиво",
+		})
+		if err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+
+		report, err := svc.EnrichAddresses(ctx, 10)
+		if err != nil {
+			t.Fatalf("EnrichAddresses: %v", err)
+		}
+		if report.Claimed != 1 || report.Retried != 1 {
+			t.Fatalf("expected one place to retry, got %+v", report)
+		}
+
+		// следующая попытка только после паузы
+		report, err = svc.EnrichAddresses(ctx, 10)
+		if err != nil {
+			t.Fatalf("EnrichAddresses: %v", err)
+		}
+		if report.Claimed != 0 {
+			t.Fatalf("expected no place due yet, got %+v", report)
+		}
+
+		got, err := svc.Get(ctx, far.ID, enum.LocaleUK)
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		if got.AddressStatus != enum.PlaceAddressStatusPending || got.AddressDetails != nil {
+			t.Fatalf("expected the address to stay pending, got %q %+v", got.AddressStatus, got.AddressDetails)
+		}
+	})
+
+	t.Run("empty lookup is retried later", func(t *testing.T) {
+		svc = place.NewService(database, emptyGuesser{})
+
+		sea, err := svc.Create(ctx, place.CreateParams{
+			CityID:      uuid.New(),
+			Class:       "food",
+			Point:       orb.Point{31.5, 46.0},
+			Locale:      enum.LocaleUK,
+			Name:        "Яхта",
+			Description: "У морі",
+		})
+		if err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+
+		report, err := svc.EnrichAddresses(ctx, 10)
+		if err != nil {
+			t.Fatalf("EnrichAddresses: %v", err)
+		}
+		if report.Claimed != 1 || report.Retried != 1 || report.Resolved != 0 {
+			t.Fatalf("expected one place to retry, got %+v", report)
+		}
+
+		got, err := svc.Get(ctx, sea.ID, enum.LocaleUK)
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		if got.AddressStatus != enum.PlaceAddressStatusPending || got.AddressDetails != nil {
+			t.Fatalf("expected the address to stay pending without details, got %q %+v", got.AddressStatus, got.AddressDetails)
+		}
+	})
+}
+
+func TestPlaceLocateAddress(t *testing.T) {
+	test.CleanDB(t)
+
+	pg, err := sql.Open("postgres", test.TestDatabaseURL)
+	if err != nil {
+		t.Fatalf("sql.Open: %v", err)
+	}
+	defer pg.Close()
+
+	ctx := context.Background()
+	database := data.New(pg)
+
+	if _, err = class.NewService(database).Create(ctx, class.CreateParams{Code: "food", Name: "Food", Icon: "food"}); err != nil {
+		t.Fatalf("CreateClass: %v", err)
+	}
+
+	fixture := func(id string, lon, lat float64, street, city string) geo.FilePlace {
+		return geo.FilePlace{ID: id, Lon: lon, Lat: lat, Addresses: map[string]models.PlaceAddress{
+			enum.LocaleEN: {
+				Formatted:   street + " 1, " + city,
+				CountryCode: "UA",
+				City:        city,
+				Street:      street,
+				HouseNumber: "1",
+			},
+		}}
+	}
+	svc := place.NewService(database, geo.NewFileProvider([]geo.FilePlace{
+		fixture("node/1", 30.5234, 50.4501, "Khreshchatyk Street", "Kyiv"),
+		fixture("node/2", 30.5235, 50.4502, "Khreshchatyk Street", "Kyiv"),
+		fixture("node/3", 24.0316, 49.8419, "Shevchenko Avenue", "Lviv"),
+		fixture("node/4", 30.7233, 46.4825, "Shevchenko Avenue", "Odesa"),
+	}))
+
+	create := func(address string) (models.Place, error) {
+		return svc.Create(ctx, place.CreateParams{
+			CityID:      uuid.New(),
+			Class:       "food",
+			Address:     address,
+			Locale:      enum.LocaleEN,
+			Name:        "Cafe",
+			Description: "Coffee",
+		})
+	}
+
+	t.Run("single match sets the point", func(t *testing.T) {
+		// две записи в нескольких метрах друг от друга считаются одним местом
+		res, err := create("Khreshchatyk 1, Kyiv")
+		if err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		if res.Point != (orb.Point{30.5234, 50.4501}) {
+			t.Fatalf("expected the point of the first candidate, got %v", res.Point)
+		}
+		if res.AddressDetails == nil || res.AddressDetails.Street != "Khreshchatyk Street" {
+			t.Fatalf("expected the address of the candidate, got %+v", res.AddressDetails)
+		}
+	})
+
+	t.Run("ambiguous match returns the candidates", func(t *testing.T) {
+		_, err := create("Shevchenko Avenue")
+		if !errors.Is(err, errx.ErrorAddressAmbiguous) {
+			t.Fatalf("expected ErrorAddressAmbiguous, got %v", err)
+		}
+
+		var ambiguous *models.AmbiguousAddress
+		if !errors.As(err, &ambiguous) {
+			t.Fatalf("expected the candidates in the error, got %v", err)
+		}
+		if len(ambiguous.Candidates) != 2 ||
+			ambiguous.Candidates[0].ID != "node/3" || ambiguous.Candidates[1].ID != "node/4" {
+			t.Fatalf("expected the Lviv and Odesa candidates, got %+v", ambiguous.Candidates)
+		}
+	})
+
+	t.Run("unknown address", func(t *testing.T) {
+		_, err := create("Unknown Street 7")
+		if !errors.Is(err, errx.ErrorAddressNotFound) {
+			t.Fatalf("expected ErrorAddressNotFound, got %v", err)
+		}
+	})
+
+	t.Run("update by address moves the point", func(t *testing.T) {
+		cafe, err := create("Khreshchatyk Street")
+		if err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+
+		address := "Shevchenko Avenue, Lviv"
+		res, err := svc.Update(ctx, cafe.ID, enum.LocaleEN, place.UpdateParams{Address: &address})
+		if err != nil {
+			t.Fatalf("Update: %v", err)
+		}
+		if res.Point != (orb.Point{24.0316, 49.8419}) {
+			t.Fatalf("expected the point in Lviv, got %v", res.Point)
+		}
+		if res.AddressStatus != enum.PlaceAddressStatusResolved || res.AddressDetails == nil ||
+			res.AddressDetails.City != "Lviv" {
+			t.Fatalf("expected the resolved Lviv address, got %q %+v", res.AddressStatus, res.AddressDetails)
+		}
+
+		// найденный адрес сохранён сразу, обратное геокодирование ему не нужно
+		report, err := svc.EnrichAddresses(ctx, 10)
+		if err != nil {
+			t.Fatalf("EnrichAddresses: %v", err)
+		}
+		if report.Claimed != 0 {
+			t.Fatalf("expected no place to look up, got %+v", report)
+		}
+		got, err := svc.Get(ctx, cafe.ID, enum.LocaleEN)
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		if got.AddressStatus != enum.PlaceAddressStatusResolved || got.AddressDetails == nil ||
+			got.AddressDetails.City != "Lviv" {
+			t.Fatalf("expected the stored Lviv address, got %q %+v", got.AddressStatus, got.AddressDetails)
+		}
+	})
+}
+
+func TestPlaceGeocodeFallback(t *testing.T) {
+	test.CleanDB(t)
+
+	pg, err := sql.Open("postgres", test.TestDatabaseURL)
+	if err != nil {
+		t.Fatalf("sql.Open: %v", err)
+	}
+	defer pg.Close()
+
+	ctx := context.Background()
+	database := data.New(pg)
+
+	if _, err = class.NewService(database).Create(ctx, class.CreateParams{Code: "food", Name: "Food", Icon: "food"}); err != nil {
+		t.Fatalf("CreateClass: %v", err)
+	}
+
+	fixture, err := json.Marshal([]geo.FilePlace{{
+		ID:  "node/1",
+		Lon: 30.5234,
+		Lat: 50.4501,
+		Addresses: map[string]models.PlaceAddress{
+			enum.LocaleEN: {Formatted: "Khreshchatyk Street 1, Kyiv", City: "Kyiv", Street: "Khreshchatyk Street"},
+		},
+	}})
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "geocode.json")
+	if err = os.WriteFile(path, fixture, 0o600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	registry := geo.NewRegistry()
+	registry.Register("down", func(geo.ProviderConfig) (geo.Provider, error) { return failingGuesser{}, nil })
+
+	t.Run("unknown provider", func(t *testing.T) {
+		if _, err := registry.Build("mapbox", geo.ProviderNone, nil); err == nil {
+			t.Fatalf("expected an error for an unknown provider")
+		}
+	})
+
+	t.Run("file provider without a fixture", func(t *testing.T) {
+		if _, err := registry.Build(geo.ProviderFile, "", nil); err == nil {
+			t.Fatalf("expected an error for a file provider without a file")
+		}
+	})
+
+	provider, err := registry.Build("down", geo.ProviderFile, map[string]geo.ProviderConfig{
+		geo.ProviderFile: {File: path},
+	})
+	if err != nil {
+		t.Fatalf("Build: %v", err)
+	}
+	svc := place.NewService(database, provider)
+
+	t.Run("secondary provider locates and guesses", func(t *testing.T) {
+		res, err := svc.Create(ctx, place.CreateParams{
+			CityID:      uuid.New(),
+			Class:       "food",
+			Address:     "Khreshchatyk 1",
+			Locale:      enum.LocaleEN,
+			Name:        "Cafe",
+			Description: "Coffee",
+		})
+		if err != nil {
+			t.Fatalf("Create: %v", err)
+		}
+		if res.Point != (orb.Point{30.5234, 50.4501}) {
+			t.Fatalf("expected the point of the fixture, got %v", res.Point)
+		}
+
+		moved := orb.Point{30.5236, 50.4502}
+		if _, err = svc.Update(ctx, res.ID, enum.LocaleEN, place.UpdateParams{Point: &moved}); err != nil {
+			t.Fatalf("Update: %v", err)
+		}
+		report, err := svc.EnrichAddresses(ctx, 10)
+		if err != nil {
+			t.Fatalf("EnrichAddresses: %v", err)
+		}
+		if report.Resolved != 1 {
+			t.Fatalf("expected the address from the secondary provider, got %+v", report)
+		}
+	})
+}
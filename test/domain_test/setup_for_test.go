@@ -5,14 +5,18 @@ import (
 	"database/sql"
 	"log"
 	"testing"
+	"time"
 
 	"github.com/chains-lab/places-svc/internal/data"
 	"github.com/chains-lab/places-svc/internal/domain/infra/geo"
 	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/chains-lab/places-svc/internal/domain/services/boundary"
 	"github.com/chains-lab/places-svc/internal/domain/services/class"
+	"github.com/chains-lab/places-svc/internal/domain/services/pimport"
 	"github.com/chains-lab/places-svc/internal/domain/services/place"
 	"github.com/chains-lab/places-svc/internal/domain/services/plocale"
 	"github.com/chains-lab/places-svc/internal/domain/services/timetable"
+	"github.com/chains-lab/places-svc/internal/domain/services/ttemplate"
 	"github.com/chains-lab/places-svc/test"
 	"github.com/google/uuid"
 )
@@ -54,7 +58,26 @@ type Place interface {
 		sort place.SortParams,
 		page, size uint64,
 	) (models.PlacesCollection, error)
+	FilterPage(
+		ctx context.Context,
+		locale string,
+		filter place.FilterParams,
+		sort place.SortParams,
+		page place.PageParams,
+	) (models.PlacesCollection, error)
+	FilterByCursor(
+		ctx context.Context,
+		locale string,
+		filter place.FilterParams,
+		sort place.SortParams,
+		params place.CursorParams,
+	) (models.PlacesCollection, error)
 	Get(ctx context.Context, placeID uuid.UUID, locale string) (models.Place, error)
+	Suggest(ctx context.Context, locale string, params place.SuggestParams) ([]models.PlaceSuggestion, error)
+	Clusters(ctx context.Context, filter place.FilterParams, bbox models.BBox, zoom uint) ([]models.PlaceCluster, error)
+	Facets(ctx context.Context, filter place.FilterParams, facets []string) (models.PlaceFacets, error)
+	Tile(ctx context.Context, locale string, filter place.FilterParams, z, x, y uint32) ([]byte, error)
+	Export(ctx context.Context, filter place.FilterParams, fn func(models.PlaceExport) error) error
 
 	Update(
 		ctx context.Context,
@@ -104,8 +127,68 @@ type Timetable interface {
 	) (models.Place, error)
 
 	GetForPlace(ctx context.Context, placeID uuid.UUID) (models.Timetable, error)
+	GetNamedForPlace(ctx context.Context, placeID uuid.UUID, name string) (models.Timetable, error)
 
 	DeleteForPlace(ctx context.Context, placeID uuid.UUID) error
+	DeleteNamedForPlace(ctx context.Context, placeID uuid.UUID, name string) error
+
+	SetDayForPlace(
+		ctx context.Context,
+		placeID uuid.UUID,
+		locale string,
+		params timetable.DayParams,
+	) (models.Place, error)
+	DeleteDayForPlace(ctx context.Context, placeID uuid.UUID, name string, weekday time.Weekday) error
+
+	SetExceptionForPlace(
+		ctx context.Context,
+		placeID uuid.UUID,
+		exception models.TimetableException,
+	) (models.TimetableException, error)
+
+	GetExceptionsForPlace(
+		ctx context.Context,
+		placeID uuid.UUID,
+		filter timetable.ExceptionsFilter,
+	) ([]models.TimetableException, error)
+
+	DeleteExceptionForPlace(ctx context.Context, placeID uuid.UUID, date time.Time) error
+
+	StatusForPlace(ctx context.Context, placeID uuid.UUID, at time.Time) (models.TimetableStatus, error)
+
+	LinkTemplateForPlace(
+		ctx context.Context,
+		placeID uuid.UUID,
+		locale string,
+		params timetable.LinkTemplateParams,
+	) (models.Place, error)
+	DetachTemplateForPlace(ctx context.Context, placeID uuid.UUID, name string) error
+}
+
+type TimetableTemplate interface {
+	Create(
+		ctx context.Context,
+		params ttemplate.CreateParams,
+	) (models.TimetableTemplate, error)
+
+	Filter(
+		ctx context.Context,
+		companyID uuid.UUID,
+		page, size uint64,
+	) (models.TimetableTemplatesCollection, error)
+	Get(ctx context.Context, templateID uuid.UUID) (models.TimetableTemplate, error)
+
+	Update(ctx context.Context, templateID uuid.UUID, params ttemplate.UpdateParams) (models.TimetableTemplate, error)
+
+	Delete(ctx context.Context, templateID uuid.UUID) error
+}
+
+type PlaceImport interface {
+	Import(ctx context.Context, rows []pimport.Row, params pimport.Params) (models.PlaceImportReport, error)
+}
+
+type Boundary interface {
+	Import(ctx context.Context, boundaries []models.Boundary) (models.BoundaryImportReport, error)
 }
 
 type domain struct {
@@ -113,6 +196,9 @@ type domain struct {
 	place     Place
 	plocale   PlaceLocales
 	timetable Timetable
+	ttemplate TimetableTemplate
+	pimport   PlaceImport
+	boundary  Boundary
 }
 
 type Setup struct {
@@ -133,6 +219,9 @@ func newSetup(t *testing.T) (Setup, error) {
 	placeSvc := place.NewService(database, geoGuesser)
 	pLocalesSvc := plocale.NewService(database)
 	timetableSvc := timetable.NewService(database)
+	templateSvc := ttemplate.NewService(database)
+	importSvc := pimport.NewService(database, placeSvc, pLocalesSvc, timetableSvc)
+	boundarySvc := boundary.NewService(database)
 
 	return Setup{
 		domain: domain{
@@ -140,6 +229,9 @@ func newSetup(t *testing.T) (Setup, error) {
 			place:     placeSvc,
 			plocale:   pLocalesSvc,
 			timetable: timetableSvc,
+			ttemplate: templateSvc,
+			pimport:   importSvc,
+			boundary:  boundarySvc,
 		},
 	}, nil
 }
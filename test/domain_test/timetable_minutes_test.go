@@ -0,0 +1,117 @@
+package domain_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/chains-lab/places-svc/internal/domain/enum"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/chains-lab/places-svc/internal/domain/services/place"
+	"github.com/chains-lab/places-svc/internal/rest/responses"
+	"github.com/chains-lab/places-svc/test"
+	"github.com/google/uuid"
+)
+
+func TestMomentMinutesRoundTrip(t *testing.T) {
+	for m := 0; m < models.WeekMinutes; m++ {
+		moment := models.NumberMinutesToMoment(m)
+
+		if got := moment.ToNumberMinutes(); got != m {
+			t.Fatalf("minute %d: round trip gave %d (%s %s)", m, got, moment.Weekday, moment.Time)
+		}
+		if want := time.Weekday(m / (24 * 60)); moment.Weekday != want {
+			t.Fatalf("minute %d: want weekday %s, got %s", m, want, moment.Weekday)
+		}
+		if want := time.Duration(m%(24*60)) * time.Minute; moment.Time != want {
+			t.Fatalf("minute %d: want time %s, got %s", m, want, moment.Time)
+		}
+
+		if got := models.NumberMinutesToMoment(m + models.WeekMinutes); got != moment {
+			t.Fatalf("minute %d: value shifted by a week gave %v, want %v", m, got, moment)
+		}
+		if got := models.NumberMinutesToMoment(m - models.WeekMinutes); got != moment {
+			t.Fatalf("minute %d: value shifted back by a week gave %v, want %v", m, got, moment)
+		}
+	}
+}
+
+// TestTimetableMinutesWriteRead stores one-minute intervals starting at every minute of the week
+// (even and odd minutes in two passes, so the intervals do not touch) and checks that both the
+// timetable query and the place JSON aggregation return them unchanged, down to the response strings.
+func TestTimetableMinutesWriteRead(t *testing.T) {
+	s, err := newSetup(t)
+	if err != nil {
+		t.Fatalf("newSetup: %v", err)
+	}
+	test.CleanDB(t)
+
+	ctx := context.Background()
+
+	FoodClass := CreateClass(s, t, "Food", "food", nil)
+
+	distributorID := uuid.New()
+
+	cafe := CreatePlace(s, t, place.CreateParams{
+		CityID:        uuid.New(),
+		DistributorID: &distributorID,
+		Class:         FoodClass.Code,
+		Point:         [2]float64{30.5, 50.4},
+		Locale:        enum.LocaleEN,
+		Name:          "Minute Cafe",
+		Address:       "Addr 1",
+		Description:   "Desc 1",
+	})
+
+	for parity := 0; parity < 2; parity++ {
+		want := models.Timetable{}
+		for m := parity; m < models.WeekMinutes; m += 2 {
+			want.Table = append(want.Table, models.TimeInterval{
+				From: models.NumberMinutesToMoment(m),
+				To:   models.NumberMinutesToMoment(m + 1),
+			})
+		}
+
+		if _, err := s.domain.timetable.SetForPlace(ctx, cafe.ID, enum.LocaleEN, want); err != nil {
+			t.Fatalf("SetForPlace(parity %d): %v", parity, err)
+		}
+
+		stored, err := s.domain.timetable.GetForPlace(ctx, cafe.ID)
+		if err != nil {
+			t.Fatalf("GetForPlace(parity %d): %v", parity, err)
+		}
+		checkMinuteTimetable(t, fmt.Sprintf("timetable, parity %d", parity), want, stored)
+
+		withDetails, err := s.domain.place.Get(ctx, cafe.ID, enum.LocaleEN)
+		if err != nil {
+			t.Fatalf("Get place(parity %d): %v", parity, err)
+		}
+		checkMinuteTimetable(t, fmt.Sprintf("place, parity %d", parity), want, withDetails.Timetable)
+
+		resp := responses.Timetable(stored)
+		for i, interval := range want.Table {
+			from, to := interval.ToNumberMinutes()
+			got := resp.Data.Attributes.Table[i]
+			if wantFrom := fmt.Sprintf("%02d:%02d", from%(24*60)/60, from%60); got.From.Time != wantFrom {
+				t.Fatalf("response interval %d: want from %s, got %s", i, wantFrom, got.From.Time)
+			}
+			if wantTo := fmt.Sprintf("%02d:%02d", to%(24*60)/60, to%60); got.To.Time != wantTo {
+				t.Fatalf("response interval %d: want to %s, got %s", i, wantTo, got.To.Time)
+			}
+		}
+	}
+}
+
+func checkMinuteTimetable(t *testing.T, name string, want, got models.Timetable) {
+	t.Helper()
+
+	if len(got.Table) != len(want.Table) {
+		t.Fatalf("%s: want %d intervals, got %d", name, len(want.Table), len(got.Table))
+	}
+	for i := range want.Table {
+		if got.Table[i] != want.Table[i] {
+			t.Fatalf("%s: interval %d: want %v, got %v", name, i, want.Table[i], got.Table[i])
+		}
+	}
+}
@@ -0,0 +1,238 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chains-lab/places-svc/internal/domain/enum"
+	"github.com/chains-lab/places-svc/internal/domain/errx"
+	"github.com/chains-lab/places-svc/internal/domain/models"
+	"github.com/chains-lab/places-svc/internal/domain/services/place"
+	"github.com/chains-lab/places-svc/internal/domain/services/timetable"
+	"github.com/chains-lab/places-svc/internal/domain/services/ttemplate"
+	"github.com/chains-lab/places-svc/test"
+	"github.com/google/uuid"
+)
+
+func TestTimetableTemplates(t *testing.T) {
+	s, err := newSetup(t)
+	if err != nil {
+		t.Fatalf("newSetup: %v", err)
+	}
+	test.CleanDB(t)
+
+	ctx := context.Background()
+
+	CafeClass := CreateClass(s, t, "Cafe", "cafe", nil)
+
+	companyID := uuid.New()
+	otherCompanyID := uuid.New()
+
+	newPlace := func(name string, company uuid.UUID) models.Place {
+		return CreatePlace(s, t, place.CreateParams{
+			CityID:        uuid.New(),
+			DistributorID: &company,
+			Class:         CafeClass.Code,
+			Point:         [2]float64{30.7, 46.4},
+			Locale:        enum.LocaleEN,
+			Name:          name,
+			Address:       "Addr",
+			Description:   "Desc",
+		})
+	}
+	first := newPlace("First", companyID)
+	second := newPlace("Second", companyID)
+	foreign := newPlace("Foreign", otherCompanyID)
+
+	monday := func(from, to time.Duration) []models.TimeInterval {
+		return []models.TimeInterval{{
+			From: models.Moment{Weekday: time.Monday, Time: from},
+			To:   models.Moment{Weekday: time.Monday, Time: to},
+		}}
+	}
+
+	tpl, err := s.domain.ttemplate.Create(ctx, ttemplate.CreateParams{
+		CompanyID: companyID,
+		Name:      "weekdays",
+		Table:     monday(9*time.Hour, 18*time.Hour),
+	})
+	if err != nil {
+		t.Fatalf("Create template: %v", err)
+	}
+
+	_, err = s.domain.ttemplate.Create(ctx, ttemplate.CreateParams{
+		CompanyID: companyID,
+		Name:      "weekdays",
+		Table:     monday(9*time.Hour, 18*time.Hour),
+	})
+	if !errors.Is(err, errx.ErrorTimetableTemplateNameAlreadyTaken) {
+		t.Fatalf("want ErrorTimetableTemplateNameAlreadyTaken, got %v", err)
+	}
+
+	for _, p := range []models.Place{first, second} {
+		if _, err = s.domain.timetable.LinkTemplateForPlace(ctx, p.ID, enum.LocaleEN, timetable.LinkTemplateParams{
+			TemplateID: tpl.ID,
+		}); err != nil {
+			t.Fatalf("LinkTemplateForPlace(%s): %v", p.Name, err)
+		}
+	}
+
+	// Mon 19:00–20:00 — попадает только в расписание после изменения шаблона
+	evening := func() []uuid.UUID {
+		res, err := s.domain.place.Filter(
+			ctx, enum.LocaleEN,
+			place.FilterParams{Time: &models.TimeInterval{
+				From: models.Moment{Weekday: time.Monday, Time: 19 * time.Hour},
+				To:   models.Moment{Weekday: time.Monday, Time: 20 * time.Hour},
+			}},
+			place.SortParams{},
+			0, 10,
+		)
+		if err != nil {
+			t.Fatalf("Filter: %v", err)
+		}
+		return idsOf(res.Data)
+	}
+
+	t.Run("link copies the template", func(t *testing.T) {
+		tt, err := s.domain.timetable.GetForPlace(ctx, first.ID)
+		if err != nil {
+			t.Fatalf("GetForPlace: %v", err)
+		}
+		if tt.TemplateID == nil || *tt.TemplateID != tpl.ID {
+			t.Fatalf("want timetable linked to %s, got %v", tpl.ID, tt.TemplateID)
+		}
+		if len(tt.Table) != 1 || tt.Table[0].To.Time != 18*time.Hour {
+			t.Fatalf("unexpected table: %+v", tt.Table)
+		}
+		if ids := evening(); len(ids) != 0 {
+			t.Fatalf("nobody is open Mon 19–20 yet, got %v", ids)
+		}
+	})
+
+	t.Run("template of another company is rejected", func(t *testing.T) {
+		_, err := s.domain.timetable.LinkTemplateForPlace(ctx, foreign.ID, enum.LocaleEN, timetable.LinkTemplateParams{
+			TemplateID: tpl.ID,
+		})
+		if !errors.Is(err, errx.ErrorTimetableTemplateOfOtherCompany) {
+			t.Fatalf("want ErrorTimetableTemplateOfOtherCompany, got %v", err)
+		}
+	})
+
+	t.Run("template update reaches linked places", func(t *testing.T) {
+		table := monday(9*time.Hour, 21*time.Hour)
+		if _, err := s.domain.ttemplate.Update(ctx, tpl.ID, ttemplate.UpdateParams{Table: &table}); err != nil {
+			t.Fatalf("Update template: %v", err)
+		}
+
+		ids := evening()
+		if len(ids) != 2 || !containsID(ids, first.ID) || !containsID(ids, second.ID) {
+			t.Fatalf("want both linked places open Mon 19–20, got %v", ids)
+		}
+
+		st, err := s.domain.timetable.StatusForPlace(ctx, second.ID, time.Date(2025, 10, 6, 20, 0, 0, 0, time.UTC))
+		if err != nil {
+			t.Fatalf("StatusForPlace: %v", err)
+		}
+		if !st.Open {
+			t.Fatalf("second place must be open Mon 20:00 after template update")
+		}
+	})
+
+	t.Run("local override detaches the place", func(t *testing.T) {
+		if _, err := s.domain.timetable.SetForPlace(ctx, second.ID, enum.LocaleEN, models.Timetable{
+			Table: monday(10*time.Hour, 12*time.Hour),
+		}); err != nil {
+			t.Fatalf("SetForPlace: %v", err)
+		}
+
+		table := monday(8*time.Hour, 23*time.Hour)
+		if _, err := s.domain.ttemplate.Update(ctx, tpl.ID, ttemplate.UpdateParams{Table: &table}); err != nil {
+			t.Fatalf("Update template: %v", err)
+		}
+
+		tt, err := s.domain.timetable.GetForPlace(ctx, second.ID)
+		if err != nil {
+			t.Fatalf("GetForPlace: %v", err)
+		}
+		if tt.TemplateID != nil || len(tt.Table) != 1 || tt.Table[0].To.Time != 12*time.Hour {
+			t.Fatalf("override must stay local, got template=%v table=%+v", tt.TemplateID, tt.Table)
+		}
+
+		ids := evening()
+		if len(ids) != 1 || ids[0] != first.ID {
+			t.Fatalf("want only first place open Mon 19–20, got %v", ids)
+		}
+	})
+
+	t.Run("detach keeps the copy", func(t *testing.T) {
+		if err := s.domain.timetable.DetachTemplateForPlace(ctx, first.ID, ""); err != nil {
+			t.Fatalf("DetachTemplateForPlace: %v", err)
+		}
+
+		table := monday(9*time.Hour, 10*time.Hour)
+		if _, err := s.domain.ttemplate.Update(ctx, tpl.ID, ttemplate.UpdateParams{Table: &table}); err != nil {
+			t.Fatalf("Update template: %v", err)
+		}
+
+		tt, err := s.domain.timetable.GetForPlace(ctx, first.ID)
+		if err != nil {
+			t.Fatalf("GetForPlace: %v", err)
+		}
+		if tt.TemplateID != nil || len(tt.Table) != 1 || tt.Table[0].To.Time != 23*time.Hour {
+			t.Fatalf("detached place must keep last copy, got template=%v table=%+v", tt.TemplateID, tt.Table)
+		}
+	})
+
+	t.Run("delete template keeps linked copies", func(t *testing.T) {
+		if _, err := s.domain.timetable.LinkTemplateForPlace(ctx, second.ID, enum.LocaleEN, timetable.LinkTemplateParams{
+			TemplateID: tpl.ID,
+		}); err != nil {
+			t.Fatalf("LinkTemplateForPlace: %v", err)
+		}
+
+		if err := s.domain.ttemplate.Delete(ctx, tpl.ID); err != nil {
+			t.Fatalf("Delete template: %v", err)
+		}
+		if _, err := s.domain.ttemplate.Get(ctx, tpl.ID); !errors.Is(err, errx.ErrorTimetableTemplateNotFound) {
+			t.Fatalf("want ErrorTimetableTemplateNotFound, got %v", err)
+		}
+
+		tt, err := s.domain.timetable.GetForPlace(ctx, second.ID)
+		if err != nil {
+			t.Fatalf("GetForPlace: %v", err)
+		}
+		if tt.TemplateID != nil || len(tt.Table) != 1 || tt.Table[0].To.Time != 10*time.Hour {
+			t.Fatalf("place must keep the template copy, got template=%v table=%+v", tt.TemplateID, tt.Table)
+		}
+	})
+
+	t.Run("filter lists company templates only", func(t *testing.T) {
+		if _, err := s.domain.ttemplate.Create(ctx, ttemplate.CreateParams{
+			CompanyID: otherCompanyID,
+			Name:      "weekdays",
+			Table:     monday(9*time.Hour, 18*time.Hour),
+		}); err != nil {
+			t.Fatalf("Create template: %v", err)
+		}
+
+		res, err := s.domain.ttemplate.Filter(ctx, companyID, 0, 10)
+		if err != nil {
+			t.Fatalf("Filter templates: %v", err)
+		}
+		if res.Total != 0 || len(res.Data) != 0 {
+			t.Fatalf("want no templates for company, got %+v", res)
+		}
+	})
+}
+
+func containsID(ids []uuid.UUID, id uuid.UUID) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
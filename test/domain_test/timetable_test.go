@@ -2,12 +2,16 @@ package domain_test
 
 import (
 	"context"
+	"errors"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/chains-lab/places-svc/internal/domain/enum"
+	"github.com/chains-lab/places-svc/internal/domain/errx"
 	"github.com/chains-lab/places-svc/internal/domain/models"
 	"github.com/chains-lab/places-svc/internal/domain/services/place"
+	"github.com/chains-lab/places-svc/internal/domain/services/timetable"
 	"github.com/chains-lab/places-svc/test"
 	"github.com/google/uuid"
 )
@@ -348,3 +352,1294 @@ func idsOf(pp []models.Place) []uuid.UUID {
 	}
 	return out
 }
+
+func TestPlaceTimetableExceptions(t *testing.T) {
+	s, err := newSetup(t)
+	if err != nil {
+		t.Fatalf("newSetup: %v", err)
+	}
+	test.CleanDB(t)
+
+	ctx := context.Background()
+
+	RestaurantClass := CreateClass(s, t, "Restaurant", "restaurant", nil)
+
+	distributorID := uuid.New()
+	city := uuid.New()
+
+	p1 := CreatePlace(s, t, place.CreateParams{
+		CityID:        city,
+		DistributorID: &distributorID,
+		Class:         RestaurantClass.Code,
+		Point:         [2]float64{30.0, 50.0},
+		Locale:        enum.LocaleEN,
+		Name:          "P1 Restaurant",
+		Address:       "Addr 1",
+		Description:   "Desc 1",
+	})
+	p2 := CreatePlace(s, t, place.CreateParams{
+		CityID:        city,
+		DistributorID: &distributorID,
+		Class:         RestaurantClass.Code,
+		Point:         [2]float64{30.1, 50.1},
+		Locale:        enum.LocaleEN,
+		Name:          "P2 Restaurant",
+		Address:       "Addr 2",
+		Description:   "Desc 2",
+	})
+
+	// p1: Mon 09:00–13:00, p2 без недельного расписания
+	if _, err := s.domain.timetable.SetForPlace(ctx, p1.ID, enum.LocaleEN, models.Timetable{
+		Table: []models.TimeInterval{{
+			From: models.Moment{Weekday: time.Monday, Time: 9 * time.Hour},
+			To:   models.Moment{Weekday: time.Monday, Time: 13 * time.Hour},
+		}},
+	}); err != nil {
+		t.Fatalf("SetPlaceTimeTable p1: %v", err)
+	}
+
+	// ближайший понедельник (фильтр по окну привязывается к нему же)
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	monday := today.AddDate(0, 0, (int(time.Monday)-int(today.Weekday())+7)%7)
+
+	win := models.TimeInterval{
+		From: models.Moment{Weekday: time.Monday, Time: 10*time.Hour + 30*time.Minute},
+		To:   models.Moment{Weekday: time.Monday, Time: 10*time.Hour + 31*time.Minute},
+	}
+	call := func() models.PlacesCollection {
+		res, err := s.domain.place.Filter(
+			ctx, enum.LocaleEN,
+			place.FilterParams{Time: &win},
+			place.SortParams{},
+			0, 10,
+		)
+		if err != nil {
+			t.Fatalf("ListPlaces: %v", err)
+		}
+		return res
+	}
+
+	t.Run("without exceptions -> only p1", func(t *testing.T) {
+		res := call()
+		if res.Total != 1 || len(res.Data) != 1 || res.Data[0].ID != p1.ID {
+			t.Fatalf("want only p1; got total=%d ids=%v", res.Total, idsOf(res.Data))
+		}
+	})
+
+	t.Run("closed exception hides p1, opening exception shows p2", func(t *testing.T) {
+		reason := "public holiday"
+		if _, err := s.domain.timetable.SetExceptionForPlace(ctx, p1.ID, models.TimetableException{
+			Date:   monday,
+			Reason: &reason,
+		}); err != nil {
+			t.Fatalf("SetExceptionForPlace p1: %v", err)
+		}
+		if _, err := s.domain.timetable.SetExceptionForPlace(ctx, p2.ID, models.TimetableException{
+			Date: monday,
+			Intervals: []models.DayInterval{
+				{From: 10 * time.Hour, To: 11 * time.Hour},
+			},
+		}); err != nil {
+			t.Fatalf("SetExceptionForPlace p2: %v", err)
+		}
+
+		res := call()
+		if res.Total != 1 || len(res.Data) != 1 || res.Data[0].ID != p2.ID {
+			t.Fatalf("want only p2; got total=%d ids=%v", res.Total, idsOf(res.Data))
+		}
+	})
+
+	t.Run("timetable includes upcoming exceptions", func(t *testing.T) {
+		tt, err := s.domain.timetable.GetForPlace(ctx, p1.ID)
+		if err != nil {
+			t.Fatalf("GetForPlace p1: %v", err)
+		}
+		if len(tt.Table) != 1 {
+			t.Fatalf("want 1 weekly interval, got %d", len(tt.Table))
+		}
+		if len(tt.Exceptions) != 1 || !tt.Exceptions[0].Closed() || !tt.Exceptions[0].Date.Equal(monday) {
+			t.Fatalf("want one closed exception on %s, got %+v", monday.Format(time.DateOnly), tt.Exceptions)
+		}
+	})
+
+	t.Run("overlapping exception intervals are rejected", func(t *testing.T) {
+		_, err := s.domain.timetable.SetExceptionForPlace(ctx, p2.ID, models.TimetableException{
+			Date: monday,
+			Intervals: []models.DayInterval{
+				{From: 10 * time.Hour, To: 12 * time.Hour},
+				{From: 11 * time.Hour, To: 13 * time.Hour},
+			},
+		})
+		if !errors.Is(err, errx.ErrorInvalidTimetableException) {
+			t.Fatalf("want ErrorInvalidTimetableException, got %v", err)
+		}
+	})
+
+	t.Run("delete exception restores weekly timetable", func(t *testing.T) {
+		if err := s.domain.timetable.DeleteExceptionForPlace(ctx, p1.ID, monday); err != nil {
+			t.Fatalf("DeleteExceptionForPlace p1: %v", err)
+		}
+		if err := s.domain.timetable.DeleteExceptionForPlace(ctx, p2.ID, monday); err != nil {
+			t.Fatalf("DeleteExceptionForPlace p2: %v", err)
+		}
+
+		res := call()
+		if res.Total != 1 || len(res.Data) != 1 || res.Data[0].ID != p1.ID {
+			t.Fatalf("want only p1; got total=%d ids=%v", res.Total, idsOf(res.Data))
+		}
+
+		err := s.domain.timetable.DeleteExceptionForPlace(ctx, p1.ID, monday)
+		if !errors.Is(err, errx.ErrorTimetableExceptionNotFound) {
+			t.Fatalf("want ErrorTimetableExceptionNotFound, got %v", err)
+		}
+	})
+
+	t.Run("exception follows the local date of the place", func(t *testing.T) {
+		// пояс, в котором сейчас уже другая дата, чем по UTC
+		zone := "Pacific/Kiritimati"
+		if time.Now().UTC().Hour() < 10 {
+			zone = "Pacific/Pago_Pago"
+		}
+		loc, err := time.LoadLocation(zone)
+		if err != nil {
+			t.Fatalf("LoadLocation: %v", err)
+		}
+		now := time.Now()
+		localNow := now.In(loc)
+		localToday := time.Date(localNow.Year(), localNow.Month(), localNow.Day(), 0, 0, 0, 0, time.UTC)
+		utcToday := time.Date(now.UTC().Year(), now.UTC().Month(), now.UTC().Day(), 0, 0, 0, 0, time.UTC)
+
+		// день недели более ранней из двух дат: по UTC окно легло бы на другую дату, чем по месту
+		wd := localToday.Weekday()
+		if utcToday.Before(localToday) {
+			wd = utcToday.Weekday()
+		}
+		bound := localToday.AddDate(0, 0, (int(wd)-int(localToday.Weekday())+7)%7)
+
+		far := CreatePlace(s, t, place.CreateParams{
+			CityID:        city,
+			DistributorID: &distributorID,
+			Class:         RestaurantClass.Code,
+			Point:         [2]float64{-157.4, 1.9},
+			Timezone:      &zone,
+			Locale:        enum.LocaleEN,
+			Name:          "Far Restaurant",
+			Address:       "Addr 3",
+			Description:   "Desc 3",
+		})
+		if _, err := s.domain.timetable.SetForPlace(ctx, far.ID, enum.LocaleEN, models.Timetable{
+			Table: []models.TimeInterval{{
+				From: models.Moment{Weekday: wd, Time: 0},
+				To:   models.Moment{Weekday: wd, Time: 23 * time.Hour},
+			}},
+		}); err != nil {
+			t.Fatalf("SetForPlace far: %v", err)
+		}
+		if _, err := s.domain.timetable.SetExceptionForPlace(ctx, far.ID, models.TimetableException{
+			Date: bound,
+		}); err != nil {
+			t.Fatalf("SetExceptionForPlace far: %v", err)
+		}
+
+		window := models.TimeInterval{
+			From: models.Moment{Weekday: wd, Time: 12 * time.Hour},
+			To:   models.Moment{Weekday: wd, Time: 12*time.Hour + time.Minute},
+		}
+		res, err := s.domain.place.Filter(ctx, enum.LocaleEN, place.FilterParams{Time: &window}, place.SortParams{}, 0, 10)
+		if err != nil {
+			t.Fatalf("Filter: %v", err)
+		}
+		for _, id := range idsOf(res.Data) {
+			if id == far.ID {
+				t.Fatalf("want the place closed on its local %s, got %v", bound.Format(time.DateOnly), idsOf(res.Data))
+			}
+		}
+	})
+
+	t.Run("upcoming exceptions start at the local date", func(t *testing.T) {
+		zone := "Pacific/Kiritimati"
+		if time.Now().UTC().Hour() < 10 {
+			zone = "Pacific/Pago_Pago"
+		}
+		loc, err := time.LoadLocation(zone)
+		if err != nil {
+			t.Fatalf("LoadLocation: %v", err)
+		}
+		now := time.Now()
+		localNow := now.In(loc)
+		localToday := time.Date(localNow.Year(), localNow.Month(), localNow.Day(), 0, 0, 0, 0, time.UTC)
+		utcToday := time.Date(now.UTC().Year(), now.UTC().Month(), now.UTC().Day(), 0, 0, 0, 0, time.UTC)
+
+		island := CreatePlace(s, t, place.CreateParams{
+			CityID:        city,
+			DistributorID: &distributorID,
+			Class:         RestaurantClass.Code,
+			Point:         [2]float64{-157.5, 2.0},
+			Timezone:      &zone,
+			Locale:        enum.LocaleEN,
+			Name:          "Island Restaurant",
+			Address:       "Addr 4",
+			Description:   "Desc 4",
+		})
+		for _, date := range []time.Time{localToday, utcToday} {
+			if _, err := s.domain.timetable.SetExceptionForPlace(ctx, island.ID, models.TimetableException{Date: date}); err != nil {
+				t.Fatalf("SetExceptionForPlace %s: %v", date.Format(time.DateOnly), err)
+			}
+		}
+
+		tt, err := s.domain.timetable.GetForPlace(ctx, island.ID)
+		if err != nil {
+			t.Fatalf("GetForPlace: %v", err)
+		}
+		if len(tt.Exceptions) == 0 || !tt.Exceptions[0].Date.Equal(localToday) {
+			t.Fatalf("want exceptions from the local %s, got %+v", localToday.Format(time.DateOnly), tt.Exceptions)
+		}
+		for _, e := range tt.Exceptions {
+			if e.Date.Before(localToday) {
+				t.Fatalf("want no exception before the local date, got %s", e.Date.Format(time.DateOnly))
+			}
+		}
+	})
+}
+
+func TestPlaceOpenAt(t *testing.T) {
+	s, err := newSetup(t)
+	if err != nil {
+		t.Fatalf("newSetup: %v", err)
+	}
+	test.CleanDB(t)
+
+	ctx := context.Background()
+
+	RestaurantClass := CreateClass(s, t, "Restaurant", "restaurant", nil)
+
+	distributorID := uuid.New()
+	city := uuid.New()
+	kyiv := "Europe/Kyiv"
+
+	local := CreatePlace(s, t, place.CreateParams{
+		CityID:        city,
+		DistributorID: &distributorID,
+		Class:         RestaurantClass.Code,
+		Point:         [2]float64{30.5, 50.4},
+		Timezone:      &kyiv,
+		Locale:        enum.LocaleEN,
+		Name:          "Kyiv Restaurant",
+		Address:       "Addr 1",
+		Description:   "Desc 1",
+	})
+	if local.Timezone != kyiv {
+		t.Fatalf("want timezone %s, got %s", kyiv, local.Timezone)
+	}
+	utc := CreatePlace(s, t, place.CreateParams{
+		CityID:        city,
+		DistributorID: &distributorID,
+		Class:         RestaurantClass.Code,
+		Point:         [2]float64{-0.1, 51.5},
+		Locale:        enum.LocaleEN,
+		Name:          "UTC Restaurant",
+		Address:       "Addr 2",
+		Description:   "Desc 2",
+	})
+	if utc.Timezone != place.DefaultTimezone {
+		t.Fatalf("want default timezone, got %s", utc.Timezone)
+	}
+
+	// обе точки: Mon 09:00–18:00 по своему локальному времени
+	for _, p := range []models.Place{local, utc} {
+		if _, err := s.domain.timetable.SetForPlace(ctx, p.ID, enum.LocaleEN, models.Timetable{
+			Table: []models.TimeInterval{{
+				From: models.Moment{Weekday: time.Monday, Time: 9 * time.Hour},
+				To:   models.Moment{Weekday: time.Monday, Time: 18 * time.Hour},
+			}},
+		}); err != nil {
+			t.Fatalf("SetPlaceTimeTable %s: %v", p.Name, err)
+		}
+	}
+
+	call := func(at time.Time) []uuid.UUID {
+		res, err := s.domain.place.Filter(
+			ctx, enum.LocaleEN,
+			place.FilterParams{OpenAt: &at},
+			place.SortParams{},
+			0, 10,
+		)
+		if err != nil {
+			t.Fatalf("ListPlaces: %v", err)
+		}
+		return idsOf(res.Data)
+	}
+
+	cases := []struct {
+		name string
+		at   time.Time
+		want []uuid.UUID
+	}{
+		// зима, Киев UTC+2: 07:30Z = 09:30 по Киеву
+		{"winter morning", time.Date(2025, 1, 6, 7, 30, 0, 0, time.UTC), []uuid.UUID{local.ID}},
+		// лето, Киев UTC+3: 06:30Z = 09:30 по Киеву
+		{"summer morning", time.Date(2025, 7, 7, 6, 30, 0, 0, time.UTC), []uuid.UUID{local.ID}},
+		// лето: 15:30Z = 18:30 по Киеву, Киев уже закрыт
+		{"summer evening", time.Date(2025, 7, 7, 15, 30, 0, 0, time.UTC), []uuid.UUID{utc.ID}},
+		// воскресенье 23:30Z — в Киеве уже понедельник 02:30, но ещё рано
+		{"sunday night", time.Date(2025, 7, 6, 23, 30, 0, 0, time.UTC), []uuid.UUID{}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := call(tc.at)
+			if len(got) != len(tc.want) {
+				t.Fatalf("want %v, got %v", tc.want, got)
+			}
+			for i := range got {
+				if got[i] != tc.want[i] {
+					t.Fatalf("want %v, got %v", tc.want, got)
+				}
+			}
+		})
+	}
+
+	t.Run("invalid timezone is rejected", func(t *testing.T) {
+		bad := "Mars/Olympus_Mons"
+		_, err := s.domain.place.Update(ctx, utc.ID, enum.LocaleEN, place.UpdateParams{Timezone: &bad})
+		if !errors.Is(err, errx.ErrorInvalidTimezone) {
+			t.Fatalf("want ErrorInvalidTimezone, got %v", err)
+		}
+	})
+}
+
+func TestPlaceTimetableOvernight(t *testing.T) {
+	s, err := newSetup(t)
+	if err != nil {
+		t.Fatalf("newSetup: %v", err)
+	}
+	test.CleanDB(t)
+
+	ctx := context.Background()
+
+	BarClass := CreateClass(s, t, "Bar", "bar", nil)
+
+	distributorID := uuid.New()
+	city := uuid.New()
+
+	bar := CreatePlace(s, t, place.CreateParams{
+		CityID:        city,
+		DistributorID: &distributorID,
+		Class:         BarClass.Code,
+		Point:         [2]float64{30.0, 50.0},
+		Locale:        enum.LocaleEN,
+		Name:          "Night Bar",
+		Address:       "Addr 1",
+		Description:   "Desc 1",
+	})
+
+	interval := func(wFrom time.Weekday, hFrom int, wTo time.Weekday, hTo int) models.TimeInterval {
+		return models.TimeInterval{
+			From: models.Moment{Weekday: wFrom, Time: time.Duration(hFrom) * time.Hour},
+			To:   models.Moment{Weekday: wTo, Time: time.Duration(hTo) * time.Hour},
+		}
+	}
+
+	// Fri 20:00 → Sat 04:00 и Sat 22:00 → Sun 02:00 (перелом недели)
+	tt := models.Timetable{
+		Table: []models.TimeInterval{
+			interval(time.Saturday, 22, time.Sunday, 2),
+			interval(time.Friday, 20, time.Saturday, 4),
+		},
+	}
+
+	t.Run("set overnight intervals and read them back", func(t *testing.T) {
+		if _, err := s.domain.timetable.SetForPlace(ctx, bar.ID, enum.LocaleEN, tt); err != nil {
+			t.Fatalf("SetPlaceTimeTable(bar): %v", err)
+		}
+
+		got, err := s.domain.timetable.GetForPlace(ctx, bar.ID)
+		if err != nil {
+			t.Fatalf("GetForPlace(bar): %v", err)
+		}
+		if len(got.Table) != 2 {
+			t.Fatalf("want 2 intervals, got %d", len(got.Table))
+		}
+
+		// упорядочено по началу: пятница, затем суббота
+		want := []models.TimeInterval{tt.Table[1], tt.Table[0]}
+		for i := range want {
+			gs, ge := got.Table[i].ToNumberMinutes()
+			ws, we := want[i].ToNumberMinutes()
+			if gs != ws || ge != we {
+				t.Fatalf("interval[%d]: want (%d,%d), got (%d,%d)", i, ws, we, gs, ge)
+			}
+		}
+	})
+
+	call := func(from, to models.Moment) []uuid.UUID {
+		win := models.TimeInterval{From: from, To: to}
+		res, err := s.domain.place.Filter(
+			ctx, enum.LocaleEN,
+			place.FilterParams{Time: &win},
+			place.SortParams{},
+			0, 10,
+		)
+		if err != nil {
+			t.Fatalf("ListPlaces: %v", err)
+		}
+		return idsOf(res.Data)
+	}
+
+	t.Run("open after midnight", func(t *testing.T) {
+		got := call(
+			models.Moment{Weekday: time.Saturday, Time: 1 * time.Hour},
+			models.Moment{Weekday: time.Saturday, Time: 1*time.Hour + time.Minute},
+		)
+		if len(got) != 1 || got[0] != bar.ID {
+			t.Fatalf("Sat 01:00: want only bar, got %v", got)
+		}
+	})
+
+	t.Run("open after the week wraps", func(t *testing.T) {
+		got := call(
+			models.Moment{Weekday: time.Sunday, Time: 1 * time.Hour},
+			models.Moment{Weekday: time.Sunday, Time: 1*time.Hour + time.Minute},
+		)
+		if len(got) != 1 || got[0] != bar.ID {
+			t.Fatalf("Sun 01:00: want only bar, got %v", got)
+		}
+	})
+
+	t.Run("closed in between", func(t *testing.T) {
+		got := call(
+			models.Moment{Weekday: time.Saturday, Time: 12 * time.Hour},
+			models.Moment{Weekday: time.Saturday, Time: 12*time.Hour + time.Minute},
+		)
+		if len(got) != 0 {
+			t.Fatalf("Sat 12:00: want nothing, got %v", got)
+		}
+	})
+
+	t.Run("overlap across midnight is rejected", func(t *testing.T) {
+		_, err := s.domain.timetable.SetForPlace(ctx, bar.ID, enum.LocaleEN, models.Timetable{
+			Table: []models.TimeInterval{
+				interval(time.Friday, 20, time.Saturday, 4),
+				interval(time.Saturday, 3, time.Saturday, 6),
+			},
+		})
+		if !errors.Is(err, errx.ErrorInvalidTimetable) {
+			t.Fatalf("want ErrorInvalidTimetable, got %v", err)
+		}
+	})
+
+	t.Run("overlap across the week wrap is rejected", func(t *testing.T) {
+		_, err := s.domain.timetable.SetForPlace(ctx, bar.ID, enum.LocaleEN, models.Timetable{
+			Table: []models.TimeInterval{
+				interval(time.Saturday, 22, time.Sunday, 2),
+				interval(time.Sunday, 1, time.Sunday, 5),
+			},
+		})
+		if !errors.Is(err, errx.ErrorInvalidTimetable) {
+			t.Fatalf("want ErrorInvalidTimetable, got %v", err)
+		}
+	})
+
+	t.Run("intervals touching at the week end stay separate", func(t *testing.T) {
+		// Sat 20:00 → 24:00 и Sun 00:00 → 10:00 введены отдельно, склеиваться не должны
+		separate := models.Timetable{
+			Table: []models.TimeInterval{
+				interval(time.Sunday, 0, time.Sunday, 10),
+				interval(time.Saturday, 20, time.Sunday, 0),
+			},
+		}
+		if _, err := s.domain.timetable.SetForPlace(ctx, bar.ID, enum.LocaleEN, separate); err != nil {
+			t.Fatalf("SetForPlace: %v", err)
+		}
+
+		got, err := s.domain.timetable.GetForPlace(ctx, bar.ID)
+		if err != nil {
+			t.Fatalf("GetForPlace: %v", err)
+		}
+		if len(got.Table) != 2 {
+			t.Fatalf("want 2 intervals, got %+v", got.Table)
+		}
+		for i, want := range separate.Table {
+			gs, ge := got.Table[i].ToNumberMinutes()
+			ws, we := want.ToNumberMinutes()
+			if gs != ws || ge != we {
+				t.Fatalf("interval[%d]: want (%d,%d), got (%d,%d)", i, ws, we, gs, ge)
+			}
+		}
+	})
+
+	t.Run("always open keeps one interval per day", func(t *testing.T) {
+		always, err := models.ParseOpeningHours("24/7")
+		if err != nil {
+			t.Fatalf("ParseOpeningHours: %v", err)
+		}
+		if _, err := s.domain.timetable.SetForPlace(ctx, bar.ID, enum.LocaleEN, always); err != nil {
+			t.Fatalf("SetForPlace: %v", err)
+		}
+
+		got, err := s.domain.timetable.GetForPlace(ctx, bar.ID)
+		if err != nil {
+			t.Fatalf("GetForPlace: %v", err)
+		}
+		if len(got.Table) != 7 {
+			t.Fatalf("want 7 intervals, got %+v", got.Table)
+		}
+		for i, ti := range got.Table {
+			if ti.From.Weekday != time.Weekday(i) || ti.From.Time != 0 || ti.To.Time != 0 {
+				t.Fatalf("interval[%d]: want the whole %s, got %+v", i, time.Weekday(i), ti)
+			}
+		}
+	})
+}
+
+func TestPlaceTimetableStatus(t *testing.T) {
+	s, err := newSetup(t)
+	if err != nil {
+		t.Fatalf("newSetup: %v", err)
+	}
+	test.CleanDB(t)
+
+	ctx := context.Background()
+
+	BarClass := CreateClass(s, t, "Bar", "bar", nil)
+
+	distributorID := uuid.New()
+	kyiv := "Europe/Kyiv"
+
+	bar := CreatePlace(s, t, place.CreateParams{
+		CityID:        uuid.New(),
+		DistributorID: &distributorID,
+		Class:         BarClass.Code,
+		Point:         [2]float64{30.5, 50.4},
+		Timezone:      &kyiv,
+		Locale:        enum.LocaleEN,
+		Name:          "Kyiv Bar",
+		Address:       "Addr 1",
+		Description:   "Desc 1",
+	})
+
+	// Mon 09:00–18:00 и Fri 20:00 → Sat 02:00 по Киеву
+	if _, err := s.domain.timetable.SetForPlace(ctx, bar.ID, enum.LocaleEN, models.Timetable{
+		Table: []models.TimeInterval{
+			{
+				From: models.Moment{Weekday: time.Monday, Time: 9 * time.Hour},
+				To:   models.Moment{Weekday: time.Monday, Time: 18 * time.Hour},
+			},
+			{
+				From: models.Moment{Weekday: time.Friday, Time: 20 * time.Hour},
+				To:   models.Moment{Weekday: time.Saturday, Time: 2 * time.Hour},
+			},
+		},
+	}); err != nil {
+		t.Fatalf("SetPlaceTimeTable(bar): %v", err)
+	}
+
+	loc, err := time.LoadLocation(kyiv)
+	if err != nil {
+		t.Fatalf("LoadLocation: %v", err)
+	}
+
+	cases := []struct {
+		name      string
+		at        time.Time
+		open      bool
+		nextOpen  time.Time
+		nextClose time.Time
+		left      int
+	}{
+		{
+			name:      "open on monday morning",
+			at:        time.Date(2025, 1, 6, 9, 35, 0, 0, loc),
+			open:      true,
+			nextOpen:  time.Date(2025, 1, 10, 20, 0, 0, 0, loc),
+			nextClose: time.Date(2025, 1, 6, 18, 0, 0, 0, loc),
+			left:      8*60 + 25,
+		},
+		{
+			name:      "open after midnight",
+			at:        time.Date(2025, 1, 11, 0, 30, 0, 0, loc),
+			open:      true,
+			nextOpen:  time.Date(2025, 1, 13, 9, 0, 0, 0, loc),
+			nextClose: time.Date(2025, 1, 11, 2, 0, 0, 0, loc),
+			left:      90,
+		},
+		{
+			name:      "closed on sunday",
+			at:        time.Date(2025, 1, 12, 12, 0, 0, 0, loc),
+			open:      false,
+			nextOpen:  time.Date(2025, 1, 13, 9, 0, 0, 0, loc),
+			nextClose: time.Date(2025, 1, 13, 18, 0, 0, 0, loc),
+			left:      21 * 60,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			st, err := s.domain.timetable.StatusForPlace(ctx, bar.ID, tc.at.UTC())
+			if err != nil {
+				t.Fatalf("StatusForPlace: %v", err)
+			}
+			if st.Open != tc.open {
+				t.Fatalf("open: want %v, got %v", tc.open, st.Open)
+			}
+			if st.NextOpen == nil || !st.NextOpen.Equal(tc.nextOpen) {
+				t.Fatalf("next open: want %s, got %v", tc.nextOpen, st.NextOpen)
+			}
+			if st.NextClose == nil || !st.NextClose.Equal(tc.nextClose) {
+				t.Fatalf("next close: want %s, got %v", tc.nextClose, st.NextClose)
+			}
+			if left := st.MinutesLeft(); left == nil || *left != tc.left {
+				t.Fatalf("minutes left: want %d, got %v", tc.left, left)
+			}
+		})
+	}
+
+	t.Run("closed exception moves next opening", func(t *testing.T) {
+		if _, err := s.domain.timetable.SetExceptionForPlace(ctx, bar.ID, models.TimetableException{
+			Date: time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC),
+		}); err != nil {
+			t.Fatalf("SetExceptionForPlace: %v", err)
+		}
+
+		st, err := s.domain.timetable.StatusForPlace(ctx, bar.ID, time.Date(2025, 1, 12, 12, 0, 0, 0, loc))
+		if err != nil {
+			t.Fatalf("StatusForPlace: %v", err)
+		}
+		want := time.Date(2025, 1, 17, 20, 0, 0, 0, loc)
+		if st.Open || st.NextOpen == nil || !st.NextOpen.Equal(want) {
+			t.Fatalf("want closed until %s, got open=%v next=%v", want, st.Open, st.NextOpen)
+		}
+	})
+}
+
+func TestPlaceTimetableOpeningHours(t *testing.T) {
+	s, err := newSetup(t)
+	if err != nil {
+		t.Fatalf("newSetup: %v", err)
+	}
+	test.CleanDB(t)
+
+	ctx := context.Background()
+
+	BarClass := CreateClass(s, t, "Bar", "bar", nil)
+
+	distributorID := uuid.New()
+
+	bar := CreatePlace(s, t, place.CreateParams{
+		CityID:        uuid.New(),
+		DistributorID: &distributorID,
+		Class:         BarClass.Code,
+		Point:         [2]float64{30.5, 50.4},
+		Locale:        enum.LocaleEN,
+		Name:          "OSM Bar",
+		Address:       "Addr 1",
+		Description:   "Desc 1",
+	})
+
+	cases := []struct {
+		in   string
+		want string
+	}{
+		{in: "Mo-Fr 09:00-18:00; Sa 10:00-14:00; PH off", want: "Mo-Fr 09:00-18:00; Sa 10:00-14:00"},
+		{in: "Mo-Fr 09:00-18:00; We off", want: "Mo-Tu,Th-Fr 09:00-18:00"},
+		{in: "Mo,We 08:00-12:00,11:00-17:00", want: "Mo,We 08:00-17:00"},
+		{in: "Fr-Sa 20:00-02:00", want: "Fr-Sa 20:00-02:00"},
+		{in: "Mo-Su 00:00-24:00", want: "24/7"},
+	}
+
+	for _, tc := range cases {
+		tt, err := models.ParseOpeningHours(tc.in)
+		if err != nil {
+			t.Fatalf("ParseOpeningHours(%q): %v", tc.in, err)
+		}
+
+		if _, err = s.domain.timetable.SetForPlace(ctx, bar.ID, enum.LocaleEN, tt); err != nil {
+			t.Fatalf("SetForPlace(%q): %v", tc.in, err)
+		}
+
+		stored, err := s.domain.timetable.GetForPlace(ctx, bar.ID)
+		if err != nil {
+			t.Fatalf("GetForPlace: %v", err)
+		}
+		if got := stored.OpeningHours(); got != tc.want {
+			t.Errorf("round trip of %q: want %q, got %q", tc.in, tc.want, got)
+		}
+	}
+
+	errCases := []struct {
+		in    string
+		token string
+		pos   int
+	}{
+		{in: "Mo-Fr 09:00-18:00; Jan 10:00-12:00", token: "Jan", pos: 19},
+		{in: "Mo-Fr sunrise-sunset", token: "sunrise", pos: 6},
+		{in: "Mo-Fr 09:00+", token: "+", pos: 11},
+		{in: "PH 10:00-12:00", token: "PH", pos: 0},
+		{in: "Mo 09:00-25:61", token: "25:61", pos: 9},
+	}
+
+	for _, tc := range errCases {
+		_, err := models.ParseOpeningHours(tc.in)
+
+		var ohErr *models.OpeningHoursError
+		if !errors.As(err, &ohErr) {
+			t.Fatalf("ParseOpeningHours(%q): expected OpeningHoursError, got %v", tc.in, err)
+		}
+		if ohErr.Token != tc.token || ohErr.Pos != tc.pos {
+			t.Errorf("ParseOpeningHours(%q): want %q at %d, got %q at %d", tc.in, tc.token, tc.pos, ohErr.Token, ohErr.Pos)
+		}
+	}
+}
+
+func TestPlaceSeasonalTimetables(t *testing.T) {
+	s, err := newSetup(t)
+	if err != nil {
+		t.Fatalf("newSetup: %v", err)
+	}
+	test.CleanDB(t)
+
+	ctx := context.Background()
+
+	CafeClass := CreateClass(s, t, "Cafe", "cafe", nil)
+
+	distributorID := uuid.New()
+
+	beach := CreatePlace(s, t, place.CreateParams{
+		CityID:        uuid.New(),
+		DistributorID: &distributorID,
+		Class:         CafeClass.Code,
+		Point:         [2]float64{30.7, 46.4},
+		Locale:        enum.LocaleEN,
+		Name:          "Beach Cafe",
+		Address:       "Addr 1",
+		Description:   "Desc 1",
+	})
+
+	monday := func(from, to time.Duration) []models.TimeInterval {
+		return []models.TimeInterval{{
+			From: models.Moment{Weekday: time.Monday, Time: from},
+			To:   models.Moment{Weekday: time.Monday, Time: to},
+		}}
+	}
+	date := func(y int, m time.Month, d int) *time.Time {
+		v := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+		return &v
+	}
+
+	// по умолчанию Mon 09–18, летом Mon 08–22, с декабря 2025 зимой Mon 10–16
+	for _, tt := range []models.Timetable{
+		{Table: monday(9*time.Hour, 18*time.Hour)},
+		{Name: "summer", ValidFrom: date(2025, 6, 1), ValidTo: date(2025, 9, 30), Table: monday(8*time.Hour, 22*time.Hour)},
+		{Name: "winter", ValidFrom: date(2025, 12, 1), Table: monday(10*time.Hour, 16*time.Hour)},
+	} {
+		if _, err := s.domain.timetable.SetForPlace(ctx, beach.ID, enum.LocaleEN, tt); err != nil {
+			t.Fatalf("SetForPlace(%q): %v", tt.Name, err)
+		}
+	}
+
+	t.Run("named timetable keeps its dates", func(t *testing.T) {
+		summer, err := s.domain.timetable.GetNamedForPlace(ctx, beach.ID, "summer")
+		if err != nil {
+			t.Fatalf("GetNamedForPlace(summer): %v", err)
+		}
+		if summer.Name != "summer" || summer.ValidFrom == nil || !summer.ValidFrom.Equal(*date(2025, 6, 1)) ||
+			summer.ValidTo == nil || !summer.ValidTo.Equal(*date(2025, 9, 30)) {
+			t.Fatalf("unexpected summer header: %+v", summer)
+		}
+		if len(summer.Table) != 1 || summer.Table[0].To.Time != 22*time.Hour {
+			t.Fatalf("unexpected summer table: %+v", summer.Table)
+		}
+
+		def, err := s.domain.timetable.GetNamedForPlace(ctx, beach.ID, models.DefaultTimetableName)
+		if err != nil {
+			t.Fatalf("GetNamedForPlace(default): %v", err)
+		}
+		if def.ValidFrom != nil || def.ValidTo != nil {
+			t.Fatalf("default timetable must not have dates: %+v", def)
+		}
+	})
+
+	t.Run("winter is active today", func(t *testing.T) {
+		active, err := s.domain.timetable.GetForPlace(ctx, beach.ID)
+		if err != nil {
+			t.Fatalf("GetForPlace: %v", err)
+		}
+		if active.Name != "winter" {
+			t.Fatalf("want winter timetable, got %q", active.Name)
+		}
+	})
+
+	openAt := func(at time.Time) bool {
+		res, err := s.domain.place.Filter(
+			ctx, enum.LocaleEN,
+			place.FilterParams{OpenAt: &at},
+			place.SortParams{},
+			0, 10,
+		)
+		if err != nil {
+			t.Fatalf("Filter: %v", err)
+		}
+		return len(res.Data) == 1 && res.Data[0].ID == beach.ID
+	}
+
+	cases := []struct {
+		name string
+		at   time.Time
+		open bool
+	}{
+		{"summer evening", time.Date(2025, 7, 7, 21, 0, 0, 0, time.UTC), true},
+		{"autumn evening falls back to default", time.Date(2025, 10, 6, 21, 0, 0, 0, time.UTC), false},
+		{"autumn morning falls back to default", time.Date(2025, 10, 6, 9, 30, 0, 0, time.UTC), true},
+		{"winter morning", time.Date(2026, 1, 5, 9, 30, 0, 0, time.UTC), false},
+		{"winter noon", time.Date(2026, 1, 5, 12, 0, 0, 0, time.UTC), true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := openAt(tc.at); got != tc.open {
+				t.Fatalf("filter: want open=%v, got %v", tc.open, got)
+			}
+
+			st, err := s.domain.timetable.StatusForPlace(ctx, beach.ID, tc.at)
+			if err != nil {
+				t.Fatalf("StatusForPlace: %v", err)
+			}
+			if st.Open != tc.open {
+				t.Fatalf("status: want open=%v, got %v", tc.open, st.Open)
+			}
+		})
+	}
+
+	t.Run("status follows the season change", func(t *testing.T) {
+		// последний понедельник лета 2025-09-29 закрывается в 22:00, следующий — уже по умолчанию
+		st, err := s.domain.timetable.StatusForPlace(ctx, beach.ID, time.Date(2025, 9, 29, 23, 0, 0, 0, time.UTC))
+		if err != nil {
+			t.Fatalf("StatusForPlace: %v", err)
+		}
+		want := time.Date(2025, 10, 6, 9, 0, 0, 0, time.UTC)
+		if st.Open || st.NextOpen == nil || !st.NextOpen.Equal(want) {
+			t.Fatalf("want closed until %s, got open=%v next=%v", want, st.Open, st.NextOpen)
+		}
+	})
+
+	t.Run("invalid validity is rejected", func(t *testing.T) {
+		_, err := s.domain.timetable.SetForPlace(ctx, beach.ID, enum.LocaleEN, models.Timetable{
+			Name:      "broken",
+			ValidFrom: date(2025, 9, 1),
+			ValidTo:   date(2025, 6, 1),
+			Table:     monday(9*time.Hour, 10*time.Hour),
+		})
+		if !errors.Is(err, errx.ErrorInvalidTimetable) {
+			t.Fatalf("want ErrorInvalidTimetable for reversed dates, got %v", err)
+		}
+
+		_, err = s.domain.timetable.SetForPlace(ctx, beach.ID, enum.LocaleEN, models.Timetable{
+			Name:      "summer-copy",
+			ValidFrom: date(2025, 6, 1),
+			ValidTo:   date(2025, 9, 30),
+			Table:     monday(9*time.Hour, 10*time.Hour),
+		})
+		if !errors.Is(err, errx.ErrorInvalidTimetable) {
+			t.Fatalf("want ErrorInvalidTimetable for duplicated dates, got %v", err)
+		}
+	})
+
+	t.Run("delete named timetable", func(t *testing.T) {
+		if err := s.domain.timetable.DeleteNamedForPlace(ctx, beach.ID, "summer"); err != nil {
+			t.Fatalf("DeleteNamedForPlace(summer): %v", err)
+		}
+		if openAt(time.Date(2025, 7, 7, 21, 0, 0, 0, time.UTC)) {
+			t.Fatalf("summer evening must fall back to default after delete")
+		}
+
+		err := s.domain.timetable.DeleteNamedForPlace(ctx, beach.ID, "summer")
+		if !errors.Is(err, errx.ErrorTimetableNotFound) {
+			t.Fatalf("want ErrorTimetableNotFound, got %v", err)
+		}
+
+		if err := s.domain.timetable.DeleteForPlace(ctx, beach.ID); err != nil {
+			t.Fatalf("DeleteForPlace: %v", err)
+		}
+		_, err = s.domain.timetable.GetNamedForPlace(ctx, beach.ID, "winter")
+		if !errors.Is(err, errx.ErrorTimetableNotFound) {
+			t.Fatalf("want ErrorTimetableNotFound after deleting all, got %v", err)
+		}
+	})
+}
+
+func TestPlaceTimetableSummary(t *testing.T) {
+	s, err := newSetup(t)
+	if err != nil {
+		t.Fatalf("newSetup: %v", err)
+	}
+	test.CleanDB(t)
+
+	ctx := context.Background()
+
+	CafeClass := CreateClass(s, t, "Cafe", "cafe", nil)
+
+	cafe := CreatePlace(s, t, place.CreateParams{
+		CityID:      uuid.New(),
+		Class:       CafeClass.Code,
+		Point:       [2]float64{30.5, 50.4},
+		Locale:      enum.LocaleEN,
+		Name:        "Cafe",
+		Address:     "Addr 1",
+		Description: "Desc 1",
+	})
+
+	day := func(wd time.Weekday, from, to time.Duration) models.TimeInterval {
+		return models.TimeInterval{
+			From: models.Moment{Weekday: wd, Time: from},
+			To:   models.Moment{Weekday: wd, Time: to},
+		}
+	}
+
+	table := make([]models.TimeInterval, 0, 6)
+	for wd := time.Monday; wd <= time.Friday; wd++ {
+		table = append(table, day(wd, 9*time.Hour, 18*time.Hour))
+	}
+	table = append(table, day(time.Saturday, 10*time.Hour, 14*time.Hour))
+
+	if _, err = s.domain.timetable.SetForPlace(ctx, cafe.ID, enum.LocaleEN, models.Timetable{Table: table}); err != nil {
+		t.Fatalf("SetForPlace: %v", err)
+	}
+
+	tt, err := s.domain.timetable.GetForPlace(ctx, cafe.ID)
+	if err != nil {
+		t.Fatalf("GetForPlace: %v", err)
+	}
+
+	cases := []struct {
+		locale string
+		hour12 bool
+		want   string
+	}{
+		{enum.LocaleEN, false, "Mon–Fri 09:00–18:00, Sat 10:00–14:00, Sun closed"},
+		{enum.LocaleEN, true, "Mon–Fri 9:00 AM–6:00 PM, Sat 10:00 AM–2:00 PM, Sun closed"},
+		{enum.LocaleRU, false, "Пн–Пт 09:00–18:00, Сб 10:00–14:00, Вс выходной"},
+		{enum.LocaleUK, false, "Пн–Пт 09:00–18:00, Сб 10:00–14:00, Нд вихідний"},
+		{"de", false, "Mon–Fri 09:00–18:00, Sat 10:00–14:00, Sun closed"},
+	}
+	for _, tc := range cases {
+		if got := tt.Summary(tc.locale, tc.hour12); got != tc.want {
+			t.Errorf("Summary(%s, 12h=%v): want %q, got %q", tc.locale, tc.hour12, tc.want, got)
+		}
+	}
+
+	t.Run("overnight and split days", func(t *testing.T) {
+		night := models.Timetable{Table: []models.TimeInterval{
+			day(time.Monday, 9*time.Hour, 12*time.Hour),
+			day(time.Monday, 13*time.Hour, 18*time.Hour),
+			{
+				From: models.Moment{Weekday: time.Friday, Time: 22 * time.Hour},
+				To:   models.Moment{Weekday: time.Saturday, Time: 2 * time.Hour},
+			},
+		}}
+		if _, err := s.domain.timetable.SetForPlace(ctx, cafe.ID, enum.LocaleEN, night); err != nil {
+			t.Fatalf("SetForPlace: %v", err)
+		}
+
+		tt, err := s.domain.timetable.GetForPlace(ctx, cafe.ID)
+		if err != nil {
+			t.Fatalf("GetForPlace: %v", err)
+		}
+
+		want := "Mon 09:00–12:00 / 13:00–18:00, Tue–Thu closed, Fri 22:00–02:00, Sat–Sun closed"
+		if got := tt.Summary(enum.LocaleEN, false); got != want {
+			t.Fatalf("want %q, got %q", want, got)
+		}
+		want = "Mon 9:00 AM–12:00 PM / 1:00 PM–6:00 PM, Tue–Thu closed, Fri 10:00 PM–2:00 AM, Sat–Sun closed"
+		if got := tt.Summary(enum.LocaleEN, true); got != want {
+			t.Fatalf("want %q, got %q", want, got)
+		}
+	})
+
+	t.Run("always open", func(t *testing.T) {
+		always, err := models.ParseOpeningHours("24/7")
+		if err != nil {
+			t.Fatalf("ParseOpeningHours: %v", err)
+		}
+		if got := always.Summary(enum.LocaleUK, false); got != "цілодобово" {
+			t.Fatalf("want цілодобово, got %q", got)
+		}
+	})
+}
+
+func TestPlaceTimetableDays(t *testing.T) {
+	s, err := newSetup(t)
+	if err != nil {
+		t.Fatalf("newSetup: %v", err)
+	}
+	test.CleanDB(t)
+
+	ctx := context.Background()
+
+	CafeClass := CreateClass(s, t, "Cafe", "cafe", nil)
+
+	cafe := CreatePlace(s, t, place.CreateParams{
+		CityID:      uuid.New(),
+		Class:       CafeClass.Code,
+		Point:       [2]float64{30.5, 50.4},
+		Locale:      enum.LocaleEN,
+		Name:        "Cafe",
+		Address:     "Addr 1",
+		Description: "Desc 1",
+	})
+
+	week := make([]models.TimeInterval, 0, 5)
+	for wd := time.Monday; wd <= time.Friday; wd++ {
+		week = append(week, models.TimeInterval{
+			From: models.Moment{Weekday: wd, Time: 9 * time.Hour},
+			To:   models.Moment{Weekday: wd, Time: 18 * time.Hour},
+		})
+	}
+	if _, err = s.domain.timetable.SetForPlace(ctx, cafe.ID, enum.LocaleEN, models.Timetable{Table: week}); err != nil {
+		t.Fatalf("SetForPlace: %v", err)
+	}
+
+	setDay := func(wd time.Weekday, intervals ...models.DayInterval) error {
+		_, err := s.domain.timetable.SetDayForPlace(ctx, cafe.ID, enum.LocaleEN, timetable.DayParams{
+			Weekday:   wd,
+			Intervals: intervals,
+		})
+		return err
+	}
+	summary := func() string {
+		tt, err := s.domain.timetable.GetForPlace(ctx, cafe.ID)
+		if err != nil {
+			t.Fatalf("GetForPlace: %v", err)
+		}
+		return tt.Summary(enum.LocaleEN, false)
+	}
+
+	t.Run("set one day keeps the others", func(t *testing.T) {
+		if err := setDay(time.Saturday, models.DayInterval{From: 10 * time.Hour, To: 14 * time.Hour}); err != nil {
+			t.Fatalf("SetDayForPlace(Sat): %v", err)
+		}
+		if err := setDay(time.Friday, models.DayInterval{From: 22 * time.Hour, To: 2 * time.Hour}); err != nil {
+			t.Fatalf("SetDayForPlace(Fri): %v", err)
+		}
+
+		want := "Mon–Thu 09:00–18:00, Fri 22:00–02:00, Sat 10:00–14:00, Sun closed"
+		if got := summary(); got != want {
+			t.Fatalf("want %q, got %q", want, got)
+		}
+	})
+
+	t.Run("overlap with the previous night is rejected", func(t *testing.T) {
+		err := setDay(time.Saturday, models.DayInterval{From: time.Hour, To: 3 * time.Hour})
+		if !errors.Is(err, errx.ErrorInvalidTimetable) {
+			t.Fatalf("want ErrorInvalidTimetable, got %v", err)
+		}
+
+		err = setDay(time.Sunday, models.DayInterval{From: 10 * time.Hour, To: 10 * time.Hour})
+		if !errors.Is(err, errx.ErrorInvalidTimetable) {
+			t.Fatalf("want ErrorInvalidTimetable for empty interval, got %v", err)
+		}
+
+		want := "Mon–Thu 09:00–18:00, Fri 22:00–02:00, Sat 10:00–14:00, Sun closed"
+		if got := summary(); got != want {
+			t.Fatalf("rejected edit must not change the timetable, got %q", got)
+		}
+	})
+
+	t.Run("concurrent edits of different days", func(t *testing.T) {
+		var wg sync.WaitGroup
+		errs := make([]error, 2)
+		for i, wd := range []time.Weekday{time.Tuesday, time.Wednesday} {
+			wg.Add(1)
+			go func(i int, wd time.Weekday) {
+				defer wg.Done()
+				errs[i] = setDay(wd, models.DayInterval{From: 8 * time.Hour, To: 12 * time.Hour})
+			}(i, wd)
+		}
+		wg.Wait()
+		for _, err := range errs {
+			if err != nil {
+				t.Fatalf("SetDayForPlace: %v", err)
+			}
+		}
+
+		want := "Mon 09:00–18:00, Tue–Wed 08:00–12:00, Thu 09:00–18:00, Fri 22:00–02:00, Sat 10:00–14:00, Sun closed"
+		if got := summary(); got != want {
+			t.Fatalf("want %q, got %q", want, got)
+		}
+	})
+
+	t.Run("delete day", func(t *testing.T) {
+		if err := s.domain.timetable.DeleteDayForPlace(ctx, cafe.ID, "", time.Friday); err != nil {
+			t.Fatalf("DeleteDayForPlace(Fri): %v", err)
+		}
+
+		want := "Mon 09:00–18:00, Tue–Wed 08:00–12:00, Thu 09:00–18:00, Fri closed, Sat 10:00–14:00, Sun closed"
+		if got := summary(); got != want {
+			t.Fatalf("want %q, got %q", want, got)
+		}
+
+		err := s.domain.timetable.DeleteDayForPlace(ctx, cafe.ID, "summer", time.Friday)
+		if !errors.Is(err, errx.ErrorTimetableNotFound) {
+			t.Fatalf("want ErrorTimetableNotFound, got %v", err)
+		}
+	})
+
+	t.Run("sunday after a saturday until midnight", func(t *testing.T) {
+		sundays := func() []models.TimeInterval {
+			t.Helper()
+			tt, err := s.domain.timetable.GetForPlace(ctx, cafe.ID)
+			if err != nil {
+				t.Fatalf("GetForPlace: %v", err)
+			}
+
+			var res []models.TimeInterval
+			for _, ti := range tt.Table {
+				if ti.From.Weekday == time.Sunday {
+					res = append(res, ti)
+				}
+			}
+			return res
+		}
+
+		if err := setDay(time.Saturday, models.DayInterval{From: 20 * time.Hour, To: 24 * time.Hour}); err != nil {
+			t.Fatalf("SetDayForPlace(Sat): %v", err)
+		}
+		if err := setDay(time.Sunday, models.DayInterval{From: 0, To: 10 * time.Hour}); err != nil {
+			t.Fatalf("SetDayForPlace(Sun): %v", err)
+		}
+		if got := sundays(); len(got) != 1 || got[0].From.Time != 0 || got[0].To.Time != 10*time.Hour {
+			t.Fatalf("want Sun 00:00–10:00, got %+v", got)
+		}
+
+		// воскресенье заменяется целиком, без пересечения со старыми часами
+		if err := setDay(time.Sunday, models.DayInterval{From: 0, To: 12 * time.Hour}); err != nil {
+			t.Fatalf("SetDayForPlace(Sun) again: %v", err)
+		}
+		if got := sundays(); len(got) != 1 || got[0].To.Time != 12*time.Hour {
+			t.Fatalf("want Sun 00:00–12:00, got %+v", got)
+		}
+
+		if err := s.domain.timetable.DeleteDayForPlace(ctx, cafe.ID, "", time.Sunday); err != nil {
+			t.Fatalf("DeleteDayForPlace(Sun): %v", err)
+		}
+		if got := sundays(); len(got) != 0 {
+			t.Fatalf("want Sunday closed, got %+v", got)
+		}
+
+		tt, err := s.domain.timetable.GetForPlace(ctx, cafe.ID)
+		if err != nil {
+			t.Fatalf("GetForPlace: %v", err)
+		}
+		var saturday []models.TimeInterval
+		for _, ti := range tt.Table {
+			if ti.From.Weekday == time.Saturday {
+				saturday = append(saturday, ti)
+			}
+		}
+		if len(saturday) != 1 || saturday[0].From.Time != 20*time.Hour ||
+			saturday[0].To != (models.Moment{Weekday: time.Sunday}) {
+			t.Fatalf("want Sat 20:00–24:00 kept, got %+v", saturday)
+		}
+	})
+}
+
+// TestActiveTimetableRules runs the same dates through the Go (models.ActiveTimetable) and the SQL
+// (filters by time) choice of the timetable in force, so the two implementations can not drift apart.
+func TestActiveTimetableRules(t *testing.T) {
+	s, err := newSetup(t)
+	if err != nil {
+		t.Fatalf("newSetup: %v", err)
+	}
+	test.CleanDB(t)
+
+	ctx := context.Background()
+
+	CafeClass := CreateClass(s, t, "Cafe", "cafe", nil)
+
+	cafe := CreatePlace(s, t, place.CreateParams{
+		CityID:      uuid.New(),
+		Class:       CafeClass.Code,
+		Point:       [2]float64{30.7, 46.4},
+		Locale:      enum.LocaleEN,
+		Name:        "Cafe",
+		Address:     "Addr 1",
+		Description: "Desc 1",
+	})
+
+	// каждое расписание открыто свой час каждый день, по открытому часу видно, какое выбрано
+	daily := func(hour int) []models.TimeInterval {
+		res := make([]models.TimeInterval, 0, 7)
+		for wd := time.Sunday; wd <= time.Saturday; wd++ {
+			res = append(res, models.TimeInterval{
+				From: models.Moment{Weekday: wd, Time: time.Duration(hour) * time.Hour},
+				To:   models.Moment{Weekday: wd, Time: time.Duration(hour+1) * time.Hour},
+			})
+		}
+		return res
+	}
+	date := func(m time.Month, d int) *time.Time {
+		v := time.Date(2025, m, d, 0, 0, 0, 0, time.UTC)
+		return &v
+	}
+
+	timetables := []models.Timetable{
+		{Name: models.DefaultTimetableName, Table: daily(0)},
+		{Name: "until-mid-june", ValidTo: date(6, 15), Table: daily(1)},
+		{Name: "summer", ValidFrom: date(6, 1), ValidTo: date(8, 31), Table: daily(2)},
+		{Name: "july", ValidFrom: date(7, 1), ValidTo: date(7, 31), Table: daily(3)},
+		{Name: "july-first-half", ValidFrom: date(7, 1), ValidTo: date(7, 15), Table: daily(4)},
+		{Name: "from-mid-august", ValidFrom: date(8, 15), Table: daily(5)},
+		{Name: "october", ValidFrom: date(10, 1), ValidTo: date(10, 10), Table: daily(6)},
+	}
+	hourOf := make(map[string]int, len(timetables))
+	for i, tt := range timetables {
+		if _, err := s.domain.timetable.SetForPlace(ctx, cafe.ID, enum.LocaleEN, tt); err != nil {
+			t.Fatalf("SetForPlace(%q): %v", tt.Name, err)
+		}
+		hourOf[tt.Name] = i
+	}
+
+	openAt := func(at time.Time) bool {
+		res, err := s.domain.place.Filter(ctx, enum.LocaleEN, place.FilterParams{OpenAt: &at}, place.SortParams{}, 0, 10)
+		if err != nil {
+			t.Fatalf("Filter: %v", err)
+		}
+		return len(res.Data) == 1
+	}
+
+	for _, tc := range []struct {
+		date *time.Time
+		want string
+	}{
+		{date(1, 10), "until-mid-june"},
+		{date(6, 1), "summer"},
+		{date(6, 15), "summer"},
+		{date(6, 16), "summer"},
+		{date(7, 1), "july-first-half"},
+		{date(7, 15), "july-first-half"},
+		{date(7, 16), "july"},
+		{date(8, 14), "summer"},
+		{date(8, 15), "from-mid-august"},
+		{date(9, 20), "from-mid-august"},
+		{date(10, 5), "october"},
+		{date(10, 11), "from-mid-august"},
+	} {
+		day := *tc.date
+		t.Run(day.Format(time.DateOnly), func(t *testing.T) {
+			active, ok := models.ActiveTimetable(timetables, day)
+			if !ok || active.Name != tc.want {
+				t.Fatalf("go: want %q, got %q", tc.want, active.Name)
+			}
+
+			var open []string
+			for _, tt := range timetables {
+				if openAt(day.Add(time.Duration(hourOf[tt.Name])*time.Hour + 30*time.Minute)) {
+					open = append(open, tt.Name)
+				}
+			}
+			if len(open) != 1 || open[0] != tc.want {
+				t.Fatalf("sql: want %q, got %v", tc.want, open)
+			}
+		})
+	}
+}